@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	gu "github.com/termkit/gama/internal/github/usecase"
+)
+
+// DeleteRuns runs `gama delete-runs [--dry-run] --older-than days repository workflow`, it deletes the completed
+// runs of the workflow created more than the days ago with their logs. The workflow is its ID, file name or name.
+// With --dry-run the runs are only listed. It returns the exit code: 1 if runs cannot be deleted or are left, and 2
+// if the arguments are wrong or the runs cannot be listed.
+func DeleteRuns(args []string, githubUseCase gu.UseCase, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("delete-runs", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	dryRun := flags.Bool("dry-run", false, "list the runs which would be deleted without deleting them")
+	days := flags.Int("older-than", 0, "delete the runs created more than this many `days` ago")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(stderr, "usage: gama delete-runs [--dry-run] --older-than days repository workflow\n\n"+
+			"Deletes the completed runs of a workflow and their logs, workflow is its ID, file name or name\n\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 2 || *days <= 0 {
+		flags.Usage()
+		return 2
+	}
+
+	// The runs which are deleted before an interrupt stay deleted, the others are kept
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	output, err := githubUseCase.DeleteOldWorkflowRuns(ctx, gu.DeleteOldWorkflowRunsInput{
+		Repository: flags.Arg(0),
+		Workflow:   flags.Arg(1),
+		Days:       *days,
+		DryRun:     *dryRun,
+	})
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "gama delete-runs: %v\n", err)
+		return 2
+	}
+
+	deleted := make(map[int64]bool)
+	if output.Deleted != nil {
+		for _, runID := range output.Deleted.Runs {
+			deleted[runID] = true
+		}
+	}
+	for _, run := range output.Runs {
+		if *dryRun || deleted[run.ID] {
+			_, _ = fmt.Fprintf(stdout, "#%d\t%s\t%s\n", run.RunNumber, run.CreatedAt.Format("2006-01-02 15:04"),
+				strings.TrimSpace(run.Title))
+		}
+	}
+
+	var skipped string
+	if output.Skipped > 0 {
+		skipped = fmt.Sprintf(", %d runs which are not completed are skipped", output.Skipped)
+	}
+
+	if *dryRun {
+		_, _ = fmt.Fprintf(stderr, "%d runs of %s older than %d days would be deleted%s\n",
+			len(output.Runs), output.Workflow, *days, skipped)
+		if output.Remaining > 0 {
+			_, _ = fmt.Fprintf(stderr, "%d more runs are not listed, GitHub lists up to 1000 runs\n", output.Remaining)
+		}
+		return 0
+	}
+
+	_, _ = fmt.Fprintf(stderr, "%d runs of %s older than %d days are deleted%s\n",
+		len(output.Deleted.Runs), output.Workflow, *days, skipped)
+	for _, failure := range output.Deleted.Failures {
+		_, _ = fmt.Fprintf(stderr, "gama delete-runs: run %d: %v\n", failure.RunID, failure.Err)
+	}
+	if output.Remaining > 0 {
+		_, _ = fmt.Fprintf(stderr, "gama delete-runs: %d runs are left, they are not listed while the listed runs cannot be deleted\n",
+			output.Remaining)
+	}
+	if len(output.Deleted.Failures) > 0 || output.Remaining > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	pw "github.com/termkit/gama/pkg/workflow"
+)
+
+// defaultLintPath is where GitHub looks for workflow files
+const defaultLintPath = ".github/workflows"
+
+// Lint runs `gama lint [path...]`, it checks the workflow files at the paths or the workflow files in the
+// directories without GitHub. The findings are printed as path:line:column: message (rule). It returns the exit code:
+// 1 if there are findings and 2 if some files cannot be read, the files which can be read are still checked.
+func Lint(args []string, stdout io.Writer, stderr io.Writer) int {
+	if slices.Contains(args, "-h") || slices.Contains(args, "--help") {
+		_, _ = fmt.Fprintf(stderr, "usage: gama lint [path...]\n\n"+
+			"Checks workflow files offline, each path is a workflow file or a directory of them (default %s)\n", defaultLintPath)
+		return 2
+	}
+
+	paths := args
+	if len(paths) == 0 {
+		paths = []string{defaultLintPath}
+	}
+
+	var files []string
+	var unreadable bool
+	for _, path := range paths {
+		pathFiles, err := workflowFiles(path)
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "gama lint: %v\n", err)
+			unreadable = true
+			continue
+		}
+		files = append(files, pathFiles...)
+	}
+
+	var findings, checked int
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "gama lint: %v\n", err)
+			unreadable = true
+			continue
+		}
+
+		checked++
+		for _, finding := range pw.Lint(data) {
+			_, _ = fmt.Fprintf(stdout, "%s:%s\n", file, finding)
+			findings++
+		}
+	}
+
+	if findings > 0 {
+		_, _ = fmt.Fprintf(stderr, "%d findings in %d workflow files\n", findings, checked)
+	}
+	switch {
+	case unreadable:
+		return 2
+	case findings > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// workflowFiles returns the path if it is a file, or the YAML files in it if it is a directory
+func workflowFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		extension := strings.ToLower(filepath.Ext(entry.Name()))
+		if !entry.IsDir() && (extension == ".yml" || extension == ".yaml") {
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no workflow files in %s", path)
+	}
+	return files, nil
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// SaveColumns writes the columns of a table, like history or repositories, to settings.columns of the config file.
+// The rest of the file is kept as it is, the file is created if there is no config file yet.
+func SaveColumns(table string, columns []string) error {
+	path := viper.ConfigFileUsed()
+	if path == "" {
+		path = filepath.Join(os.Getenv("HOME"), ".config", "gama", "config.yaml")
+	}
+
+	var document yaml.Node
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return fmt.Errorf("failed to read config file: %w", err)
+	default:
+		if err := yaml.Unmarshal(data, &document); err != nil {
+			return fmt.Errorf("failed to parse config file: %w", err)
+		}
+	}
+
+	if len(document.Content) == 0 {
+		document = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("config file %s is not a mapping", path)
+	}
+
+	sequence := &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}
+	for _, column := range columns {
+		sequence.Content = append(sequence.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: column})
+	}
+	setMappingValue(mappingChild(mappingChild(root, "settings"), "columns"), table, sequence)
+
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&document); err != nil {
+		return fmt.Errorf("failed to encode config file: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, out.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
+
+// mappingChild returns the mapping under the key, the key is added if it is missing or not a mapping
+func mappingChild(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key && node.Content[i+1].Kind == yaml.MappingNode {
+			return node.Content[i+1]
+		}
+	}
+	child := &yaml.Node{Kind: yaml.MappingNode}
+	setMappingValue(node, key, child)
+	return child
+}
+
+func setMappingValue(node *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content[i+1] = value
+			return
+		}
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+}
@@ -21,6 +21,22 @@ type Settings struct {
 		Enabled  bool          `mapstructure:"enabled"`
 		Interval time.Duration `mapstructure:"interval"`
 	} `mapstructure:"live_mode"`
+	Columns   Columns   `mapstructure:"columns"`
+	MultiRepo MultiRepo `mapstructure:"multi_repo"`
+}
+
+// MultiRepo is the set of repositories of the multi-repo history
+type MultiRepo struct {
+	Repositories  []string `mapstructure:"repositories"`  // repositories like owner/name
+	Organizations []string `mapstructure:"organizations"` // every repository of the organizations, archived ones are skipped
+	Workers       int      `mapstructure:"workers"`       // number of repositories fetched at once
+}
+
+// Columns are the columns the tables show in their order, the default columns are shown if a list is empty
+type Columns struct {
+	History      []string `mapstructure:"history"`
+	Repositories []string `mapstructure:"repositories"`
+	MultiRepo    []string `mapstructure:"multi_repo"`
 }
 
 type Github struct {
@@ -28,13 +44,19 @@ type Github struct {
 }
 
 type Shortcuts struct {
-	SwitchTabRight string `mapstructure:"switch_tab_right"`
-	SwitchTabLeft  string `mapstructure:"switch_tab_left"`
-	Quit           string `mapstructure:"quit"`
-	Refresh        string `mapstructure:"refresh"`
-	Enter          string `mapstructure:"enter"`
-	LiveMode       string `mapstructure:"live_mode"`
-	Tab            string `mapstructure:"tab"`
+	SwitchTabRight     string `mapstructure:"switch_tab_right"`
+	SwitchTabLeft      string `mapstructure:"switch_tab_left"`
+	Quit               string `mapstructure:"quit"`
+	Refresh            string `mapstructure:"refresh"`
+	Enter              string `mapstructure:"enter"`
+	LiveMode           string `mapstructure:"live_mode"`
+	Tab                string `mapstructure:"tab"`
+	SwitchRefMode      string `mapstructure:"switch_ref_mode"`
+	ToggleAllWorkflows string `mapstructure:"toggle_all_workflows"`
+	JobsPreview        string `mapstructure:"jobs_preview"`
+	SortBy             string `mapstructure:"sort_by"`
+	SortOrder          string `mapstructure:"sort_order"`
+	Columns            string `mapstructure:"columns"`
 }
 
 func LoadConfig() (*Config, error) {
@@ -7,5 +7,9 @@ func fillDefaultSettings(cfg *Config) *Config {
 		cfg.Settings.LiveMode.Interval = 15 * time.Second
 	}
 
+	if cfg.Settings.MultiRepo.Workers <= 0 {
+		cfg.Settings.MultiRepo.Workers = 4
+	}
+
 	return cfg
 }
@@ -29,35 +29,77 @@ func fillDefaultShortcuts(cfg *Config) *Config {
 	if liveMode == "" {
 		liveMode = defaultKeyMap.LiveMode
 	}
+	var switchRefMode = cfg.Shortcuts.SwitchRefMode
+	if switchRefMode == "" {
+		switchRefMode = defaultKeyMap.SwitchRefMode
+	}
+	var toggleAllWorkflows = cfg.Shortcuts.ToggleAllWorkflows
+	if toggleAllWorkflows == "" {
+		toggleAllWorkflows = defaultKeyMap.ToggleAllWorkflows
+	}
+	var jobsPreview = cfg.Shortcuts.JobsPreview
+	if jobsPreview == "" {
+		jobsPreview = defaultKeyMap.JobsPreview
+	}
+	var sortBy = cfg.Shortcuts.SortBy
+	if sortBy == "" {
+		sortBy = defaultKeyMap.SortBy
+	}
+	var sortOrder = cfg.Shortcuts.SortOrder
+	if sortOrder == "" {
+		sortOrder = defaultKeyMap.SortOrder
+	}
+	var columns = cfg.Shortcuts.Columns
+	if columns == "" {
+		columns = defaultKeyMap.Columns
+	}
 	cfg.Shortcuts = Shortcuts{
-		SwitchTabRight: switchTabRight,
-		SwitchTabLeft:  switchTabLeft,
-		Quit:           quit,
-		Refresh:        refresh,
-		LiveMode:       liveMode,
-		Enter:          enter,
-		Tab:            tab,
+		SwitchTabRight:     switchTabRight,
+		SwitchTabLeft:      switchTabLeft,
+		Quit:               quit,
+		Refresh:            refresh,
+		LiveMode:           liveMode,
+		Enter:              enter,
+		Tab:                tab,
+		SwitchRefMode:      switchRefMode,
+		ToggleAllWorkflows: toggleAllWorkflows,
+		JobsPreview:        jobsPreview,
+		SortBy:             sortBy,
+		SortOrder:          sortOrder,
+		Columns:            columns,
 	}
 
 	return cfg
 }
 
 type defaultMap struct {
-	SwitchTabRight string
-	SwitchTabLeft  string
-	Quit           string
-	Refresh        string
-	Enter          string
-	Tab            string
-	LiveMode       string
+	SwitchTabRight     string
+	SwitchTabLeft      string
+	Quit               string
+	Refresh            string
+	Enter              string
+	Tab                string
+	LiveMode           string
+	SwitchRefMode      string
+	ToggleAllWorkflows string
+	JobsPreview        string
+	SortBy             string
+	SortOrder          string
+	Columns            string
 }
 
 var defaultKeyMap = defaultMap{
-	SwitchTabRight: "shift+right",
-	SwitchTabLeft:  "shift+left",
-	Quit:           "ctrl+c",
-	Refresh:        "ctrl+r",
-	Enter:          "enter",
-	Tab:            "tab",
-	LiveMode:       "ctrl+l",
+	SwitchTabRight:     "shift+right",
+	SwitchTabLeft:      "shift+left",
+	Quit:               "ctrl+c",
+	Refresh:            "ctrl+r",
+	Enter:              "enter",
+	Tab:                "tab",
+	LiveMode:           "ctrl+l",
+	SwitchRefMode:      "ctrl+t",
+	ToggleAllWorkflows: "ctrl+o",
+	JobsPreview:        "ctrl+p",
+	SortBy:             "ctrl+s",
+	SortOrder:          "ctrl+x",
+	Columns:            "ctrl+g",
 }
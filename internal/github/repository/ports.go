@@ -2,21 +2,41 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/termkit/gama/internal/github/domain"
 )
 
 type Repository interface {
 	ListRepositories(ctx context.Context, limit int, skip int, sort domain.SortBy) ([]GithubRepository, error)
+	ListOrganizationRepositories(ctx context.Context, organization string) ([]GithubRepository, error)
 	GetAuthUser(ctx context.Context) (*GithubUser, error)
 	GetRepository(ctx context.Context, repository string) (*GithubRepository, error)
 	ListBranches(ctx context.Context, repository string) ([]GithubBranch, error)
-	ListWorkflowRuns(ctx context.Context, repository string) (*WorkflowRuns, error)
+	ListTags(ctx context.Context, repository string) ([]GithubTag, error)
+	ListEnvironments(ctx context.Context, repository string) ([]GithubEnvironment, error)
+	ListWorkflowRuns(ctx context.Context, repository string, filter WorkflowRunsFilter) (*WorkflowRuns, error)
+	GetWorkflowRun(ctx context.Context, repository string, runId int64) (*WorkflowRun, error)
+	GetWorkflowRunAttempt(ctx context.Context, repository string, runId int64, attempt int) (*WorkflowRun, error)
+	GetWorkflowRunTiming(ctx context.Context, repository string, runId int64) (*WorkflowRunTiming, error)
+	GetWorkflowTiming(ctx context.Context, repository string, workflowId int64) (*WorkflowTiming, error)
+	ListWorkflowRunJobs(ctx context.Context, repository string, runId int64) ([]WorkflowJob, error)
 	TriggerWorkflow(ctx context.Context, repository string, branch string, workflowName string, workflow any) error
 	GetWorkflows(ctx context.Context, repository string) ([]Workflow, error)
+	GetWorkflow(ctx context.Context, repository string, workflow string) (*Workflow, error)
 	GetTriggerableWorkflows(ctx context.Context, repository string) ([]Workflow, error)
+	GetWorkflowsWithTriggers(ctx context.Context, repository string) ([]Workflow, error)
 	InspectWorkflowContent(ctx context.Context, repository string, branch string, workflowFile string) ([]byte, error)
-	ReRunFailedJobs(ctx context.Context, repository string, runId int64) error
-	ReRunWorkflow(ctx context.Context, repository string, runId int64) error
+	ReRunFailedJobs(ctx context.Context, repository string, runId int64, enableDebugLogging bool) error
+	ListPendingDeployments(ctx context.Context, repository string, runId int64) ([]PendingDeployment, error)
+	ReviewPendingDeployments(ctx context.Context, repository string, runId int64, environmentIds []int64, state string, comment string) error
+	ReRunWorkflow(ctx context.Context, repository string, runId int64, enableDebugLogging bool) error
+	ReRunJob(ctx context.Context, repository string, jobId int64, enableDebugLogging bool) error
 	CancelWorkflow(ctx context.Context, repository string, runId int64) error
+	ForceCancelWorkflow(ctx context.Context, repository string, runID int64) error
+	DeleteWorkflowRun(ctx context.Context, repository string, runID int64) error
+	DeleteWorkflowRunLogs(ctx context.Context, repository string, runID int64) error
+	EnableWorkflow(ctx context.Context, repository string, workflowId int64) error
+	DisableWorkflow(ctx context.Context, repository string, workflowId int64) error
+	CreateRepositoryDispatch(ctx context.Context, repository string, eventType string, clientPayload json.RawMessage) error
 }
@@ -17,7 +17,7 @@ import (
 	"github.com/termkit/gama/internal/config"
 
 	"github.com/termkit/gama/internal/github/domain"
-	"gopkg.in/yaml.v3"
+	py "github.com/termkit/gama/pkg/yaml"
 )
 
 type Repo struct {
@@ -98,20 +98,119 @@ func (r *Repo) workerListRepositories(ctx context.Context, limit int, page int,
 	results <- repositories
 }
 
+func (r *Repo) ListOrganizationRepositories(ctx context.Context, organization string) ([]GithubRepository, error) {
+	// List all repositories of the organization, page by page until a page is not full
+	const perPage = 100
+
+	var repositories []GithubRepository
+	for page := 1; ; page++ {
+		var pageRepositories []GithubRepository
+		err := r.do(ctx, nil, &pageRepositories, requestOptions{
+			method: http.MethodGet,
+			paths:  []string{"orgs", organization, "repos"},
+			queryParams: map[string]string{
+				"type":     "all",
+				"per_page": strconv.Itoa(perPage),
+				"page":     strconv.Itoa(page),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		repositories = append(repositories, pageRepositories...)
+		if len(pageRepositories) < perPage {
+			break
+		}
+	}
+
+	return repositories, nil
+}
+
 func (r *Repo) ListBranches(ctx context.Context, repository string) ([]GithubBranch, error) {
-	// List branches for the given repository
+	// List all branches for the given repository, page by page until a page is not full
+	const perPage = 100
+
 	var branches []GithubBranch
-	err := r.do(ctx, nil, &branches, requestOptions{
-		method: http.MethodGet,
-		paths:  []string{"repos", repository, "branches"},
-	})
-	if err != nil {
-		return nil, err
+	for page := 1; ; page++ {
+		var pageBranches []GithubBranch
+		err := r.do(ctx, nil, &pageBranches, requestOptions{
+			method: http.MethodGet,
+			paths:  []string{"repos", repository, "branches"},
+			queryParams: map[string]string{
+				"per_page": strconv.Itoa(perPage),
+				"page":     strconv.Itoa(page),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		branches = append(branches, pageBranches...)
+		if len(pageBranches) < perPage {
+			break
+		}
 	}
 
 	return branches, nil
 }
 
+func (r *Repo) ListTags(ctx context.Context, repository string) ([]GithubTag, error) {
+	// List all tags for the given repository, page by page until a page is not full
+	const perPage = 100
+
+	var tags []GithubTag
+	for page := 1; ; page++ {
+		var pageTags []GithubTag
+		err := r.do(ctx, nil, &pageTags, requestOptions{
+			method: http.MethodGet,
+			paths:  []string{"repos", repository, "tags"},
+			queryParams: map[string]string{
+				"per_page": strconv.Itoa(perPage),
+				"page":     strconv.Itoa(page),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, pageTags...)
+		if len(pageTags) < perPage {
+			break
+		}
+	}
+
+	return tags, nil
+}
+
+func (r *Repo) ListEnvironments(ctx context.Context, repository string) ([]GithubEnvironment, error) {
+	// List all deployment environments for the given repository, page by page until a page is not full
+	const perPage = 100
+
+	var environments []GithubEnvironment
+	for page := 1; ; page++ {
+		var pageEnvironments githubEnvironments
+		err := r.do(ctx, nil, &pageEnvironments, requestOptions{
+			method: http.MethodGet,
+			paths:  []string{"repos", repository, "environments"},
+			queryParams: map[string]string{
+				"per_page": strconv.Itoa(perPage),
+				"page":     strconv.Itoa(page),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		environments = append(environments, pageEnvironments.Environments...)
+		if len(pageEnvironments.Environments) < perPage {
+			break
+		}
+	}
+
+	return environments, nil
+}
+
 func (r *Repo) GetRepository(ctx context.Context, repository string) (*GithubRepository, error) {
 	var repo GithubRepository
 	err := r.do(ctx, nil, &repo, requestOptions{
@@ -125,12 +224,37 @@ func (r *Repo) GetRepository(ctx context.Context, repository string) (*GithubRep
 	return &repo, nil
 }
 
-func (r *Repo) ListWorkflowRuns(ctx context.Context, repository string) (*WorkflowRuns, error) {
-	// List workflow runs for the given repository and branch
+func (r *Repo) ListWorkflowRuns(ctx context.Context, repository string, filter WorkflowRunsFilter) (*WorkflowRuns, error) {
+	// List workflow runs for the given repository, only the fields of the filter which are set are sent
+	var queryParams = make(map[string]string)
+	for key, value := range map[string]string{
+		"actor":   filter.Actor,
+		"branch":  filter.Branch,
+		"event":   filter.Event,
+		"status":  filter.Status,
+		"created": filter.Created,
+	} {
+		if value != "" {
+			queryParams[key] = value
+		}
+	}
+	if filter.Page > 0 {
+		queryParams["page"] = strconv.Itoa(filter.Page)
+	}
+	if filter.PerPage > 0 {
+		queryParams["per_page"] = strconv.Itoa(filter.PerPage)
+	}
+
+	paths := []string{"repos", repository, "actions", "runs"}
+	if filter.WorkflowID != 0 {
+		paths = []string{"repos", repository, "actions", "workflows", strconv.FormatInt(filter.WorkflowID, 10), "runs"}
+	}
+
 	var workflowRuns WorkflowRuns
 	err := r.do(ctx, nil, &workflowRuns, requestOptions{
-		method: http.MethodGet,
-		paths:  []string{"repos", repository, "actions", "runs"},
+		method:      http.MethodGet,
+		paths:       paths,
+		queryParams: queryParams,
 	})
 	if err != nil {
 		return nil, err
@@ -139,11 +263,99 @@ func (r *Repo) ListWorkflowRuns(ctx context.Context, repository string) (*Workfl
 	return &workflowRuns, nil
 }
 
+func (r *Repo) GetWorkflowRun(ctx context.Context, repository string, runID int64) (*WorkflowRun, error) {
+	var workflowRun WorkflowRun
+	err := r.do(ctx, nil, &workflowRun, requestOptions{
+		method: http.MethodGet,
+		paths:  []string{"repos", repository, "actions", "runs", strconv.FormatInt(runID, 10)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflowRun, nil
+}
+
+func (r *Repo) GetWorkflowRunAttempt(ctx context.Context, repository string, runID int64, attempt int) (*WorkflowRun, error) {
+	var workflowRun WorkflowRun
+	err := r.do(ctx, nil, &workflowRun, requestOptions{
+		method: http.MethodGet,
+		paths: []string{"repos", repository, "actions", "runs", strconv.FormatInt(runID, 10),
+			"attempts", strconv.Itoa(attempt)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflowRun, nil
+}
+
+func (r *Repo) GetWorkflowRunTiming(ctx context.Context, repository string, runID int64) (*WorkflowRunTiming, error) {
+	var timing WorkflowRunTiming
+	err := r.do(ctx, nil, &timing, requestOptions{
+		method: http.MethodGet,
+		paths:  []string{"repos", repository, "actions", "runs", strconv.FormatInt(runID, 10), "timing"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &timing, nil
+}
+
+func (r *Repo) GetWorkflowTiming(ctx context.Context, repository string, workflowID int64) (*WorkflowTiming, error) {
+	var timing WorkflowTiming
+	err := r.do(ctx, nil, &timing, requestOptions{
+		method: http.MethodGet,
+		paths:  []string{"repos", repository, "actions", "workflows", strconv.FormatInt(workflowID, 10), "timing"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &timing, nil
+}
+
+func (r *Repo) ListWorkflowRunJobs(ctx context.Context, repository string, runID int64) ([]WorkflowJob, error) {
+	// List the jobs of the latest attempt of the run, page by page until a page is not full
+	const perPage = 100
+
+	var jobs []WorkflowJob
+	for page := 1; ; page++ {
+		var pageJobs WorkflowJobs
+		err := r.do(ctx, nil, &pageJobs, requestOptions{
+			method: http.MethodGet,
+			paths:  []string{"repos", repository, "actions", "runs", strconv.FormatInt(runID, 10), "jobs"},
+			queryParams: map[string]string{
+				"filter":   "latest",
+				"per_page": strconv.Itoa(perPage),
+				"page":     strconv.Itoa(page),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		jobs = append(jobs, pageJobs.Jobs...)
+		if len(pageJobs.Jobs) < perPage {
+			break
+		}
+	}
+
+	return jobs, nil
+}
+
 func (r *Repo) TriggerWorkflow(ctx context.Context, repository string, branch string, workflowName string, workflow any) error {
-	var payload = fmt.Sprintf(`{"ref": "%s", "inputs": %s}`, branch, workflow)
+	// The ref can be a branch, a tag or any other git ref, so it is escaped as a JSON string
+	ref, err := json.Marshal(branch)
+	if err != nil {
+		return err
+	}
+
+	var payload = fmt.Sprintf(`{"ref": %s, "inputs": %s}`, ref, workflow)
 
 	// Trigger a workflow for the given repository and branch
-	err := r.do(ctx, payload, nil, requestOptions{
+	err = r.do(ctx, payload, nil, requestOptions{
 		method:      http.MethodPost,
 		paths:       []string{"repos", repository, "actions", "workflows", path.Base(workflowName), "dispatches"},
 		accept:      "application/vnd.github+json",
@@ -157,33 +369,71 @@ func (r *Repo) TriggerWorkflow(ctx context.Context, repository string, branch st
 }
 
 func (r *Repo) GetWorkflows(ctx context.Context, repository string) ([]Workflow, error) {
-	// Get a workflow run for the given repository and runID
-	var githubWorkflow githubWorkflow
+	// Get all the workflows of the given repository, page by page until a page is not full
+	const perPage = 100
+
+	var workflows []Workflow
+	for page := 1; ; page++ {
+		var pageWorkflows githubWorkflow
+		err := r.do(ctx, nil, &pageWorkflows, requestOptions{
+			method: http.MethodGet,
+			paths:  []string{"repos", repository, "actions", "workflows"},
+			queryParams: map[string]string{
+				"per_page": strconv.Itoa(perPage),
+				"page":     strconv.Itoa(page),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		workflows = append(workflows, pageWorkflows.Workflows...)
+		if len(pageWorkflows.Workflows) < perPage {
+			break
+		}
+	}
+
+	return workflows, nil
+}
+
+// GetWorkflow gets the workflow by its ID or its file name
+func (r *Repo) GetWorkflow(ctx context.Context, repository string, workflow string) (*Workflow, error) {
+	var githubWorkflow Workflow
 	err := r.do(ctx, nil, &githubWorkflow, requestOptions{
 		method: http.MethodGet,
-		paths:  []string{"repos", repository, "actions", "workflows"},
+		paths:  []string{"repos", repository, "actions", "workflows", path.Base(workflow)},
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return githubWorkflow.Workflows, nil
+	return &githubWorkflow, nil
 }
 
 func (r *Repo) GetTriggerableWorkflows(ctx context.Context, repository string) ([]Workflow, error) {
-	// Get a workflow run for the given repository and runID
-	var workflows githubWorkflow
-	err := r.do(ctx, nil, &workflows, requestOptions{
-		method: http.MethodGet,
-		paths:  []string{"repos", repository, "actions", "workflows"},
-	})
+	workflows, err := r.GetWorkflowsWithTriggers(ctx, repository)
+
+	// Keep only the workflows that can be triggered by "workflow_dispatch"
+	var result []Workflow
+	for _, workflow := range workflows {
+		if workflow.Triggers.Has("workflow_dispatch") {
+			result = append(result, workflow)
+		}
+	}
+
+	return result, err
+}
+
+func (r *Repo) GetWorkflowsWithTriggers(ctx context.Context, repository string) ([]Workflow, error) {
+	// Get the workflows of the given repository
+	workflows, err := r.GetWorkflows(ctx, repository)
 	if err != nil {
 		return nil, err
 	}
 
 	// Count how many workflows we'll actually process
 	var validWorkflowCount int
-	for _, workflow := range workflows.Workflows {
+	for _, workflow := range workflows {
 		if strings.HasPrefix(workflow.Path, ".github/workflows/") {
 			validWorkflowCount++
 		}
@@ -194,9 +444,9 @@ func (r *Repo) GetTriggerableWorkflows(ctx context.Context, repository string) (
 	errs := make(chan error, validWorkflowCount)
 
 	// Only process workflows with valid paths
-	for _, workflow := range workflows.Workflows {
+	for _, workflow := range workflows {
 		if strings.HasPrefix(workflow.Path, ".github/workflows/") {
-			go r.workerGetTriggerableWorkflows(ctx, repository, workflow, results, errs)
+			go r.workerGetWorkflowTriggers(ctx, repository, workflow, results, errs)
 		}
 	}
 
@@ -217,7 +467,7 @@ func (r *Repo) GetTriggerableWorkflows(ctx context.Context, repository string) (
 	return result, errors.Join(resultErrs...)
 }
 
-func (r *Repo) workerGetTriggerableWorkflows(ctx context.Context, repository string, workflow Workflow, results chan<- *Workflow, errs chan<- error) {
+func (r *Repo) workerGetWorkflowTriggers(ctx context.Context, repository string, workflow Workflow, results chan<- *Workflow, errs chan<- error) {
 	// Get the workflow file content
 	fileContent, err := r.getWorkflowFile(ctx, repository, workflow.Path)
 	if err != nil {
@@ -226,21 +476,16 @@ func (r *Repo) workerGetTriggerableWorkflows(ctx context.Context, repository str
 	}
 
 	// Parse the workflow file content as YAML
-	var wfFile workflowFile
-	err = yaml.Unmarshal([]byte(fileContent), &wfFile)
+	wfFile, err := py.UnmarshalWorkflowContent([]byte(fileContent))
 	if err != nil {
 		errs <- err
 		return
 	}
 
-	var dispatchWorkflow *Workflow
+	// "on" can be a string, a list or a map, the parsed triggers cover all of them
+	workflow.Triggers = wfFile.On
 
-	// Check if the workflow file content has a "workflow_dispatch" key
-	if _, ok := wfFile.On["workflow_dispatch"]; ok {
-		dispatchWorkflow = &workflow
-	}
-
-	results <- dispatchWorkflow
+	results <- &workflow
 }
 
 func (r *Repo) InspectWorkflowContent(ctx context.Context, repository string, branch string, workflowFile string) ([]byte, error) {
@@ -288,9 +533,9 @@ func (r *Repo) getWorkflowFile(ctx context.Context, repository string, path stri
 	return string(decodedContent), nil
 }
 
-func (r *Repo) ReRunFailedJobs(ctx context.Context, repository string, runID int64) error {
+func (r *Repo) ReRunFailedJobs(ctx context.Context, repository string, runID int64, enableDebugLogging bool) error {
 	// Re-run failed jobs for a given workflow run
-	err := r.do(ctx, nil, nil, requestOptions{
+	err := r.do(ctx, githubReRun{EnableDebugLogging: enableDebugLogging}, nil, requestOptions{
 		method: http.MethodPost,
 		paths:  []string{"repos", repository, "actions", "runs", strconv.FormatInt(runID, 10), "rerun-failed-jobs"},
 	})
@@ -301,9 +546,40 @@ func (r *Repo) ReRunFailedJobs(ctx context.Context, repository string, runID int
 	return nil
 }
 
-func (r *Repo) ReRunWorkflow(ctx context.Context, repository string, runID int64) error {
+func (r *Repo) ListPendingDeployments(ctx context.Context, repository string, runID int64) ([]PendingDeployment, error) {
+	// List the deployments of a waiting run which wait for a review of their environments
+	var pendingDeployments []PendingDeployment
+	err := r.do(ctx, nil, &pendingDeployments, requestOptions{
+		method: http.MethodGet,
+		paths:  []string{"repos", repository, "actions", "runs", strconv.FormatInt(runID, 10), "pending_deployments"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pendingDeployments, nil
+}
+
+func (r *Repo) ReviewPendingDeployments(ctx context.Context, repository string, runID int64, environmentIDs []int64, state string, comment string) error {
+	// Approve or reject the deployments of a waiting run to the environments, state is approved or rejected
+	err := r.do(ctx, githubDeploymentReview{
+		EnvironmentIDs: environmentIDs,
+		State:          state,
+		Comment:        comment,
+	}, nil, requestOptions{
+		method: http.MethodPost,
+		paths:  []string{"repos", repository, "actions", "runs", strconv.FormatInt(runID, 10), "pending_deployments"},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repo) ReRunWorkflow(ctx context.Context, repository string, runID int64, enableDebugLogging bool) error {
 	// Re-run a given workflow run
-	err := r.do(ctx, nil, nil, requestOptions{
+	err := r.do(ctx, githubReRun{EnableDebugLogging: enableDebugLogging}, nil, requestOptions{
 		method: http.MethodPost,
 		paths:  []string{"repos", repository, "actions", "runs", strconv.FormatInt(runID, 10), "rerun"},
 	})
@@ -314,6 +590,19 @@ func (r *Repo) ReRunWorkflow(ctx context.Context, repository string, runID int64
 	return nil
 }
 
+func (r *Repo) ReRunJob(ctx context.Context, repository string, jobID int64, enableDebugLogging bool) error {
+	// Re-run a job of a workflow run, the jobs which depend on it are re-run too
+	err := r.do(ctx, githubReRun{EnableDebugLogging: enableDebugLogging}, nil, requestOptions{
+		method: http.MethodPost,
+		paths:  []string{"repos", repository, "actions", "jobs", strconv.FormatInt(jobID, 10), "rerun"},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (r *Repo) CancelWorkflow(ctx context.Context, repository string, runID int64) error {
 	// Cancel a given workflow run
 	err := r.do(ctx, nil, nil, requestOptions{
@@ -327,6 +616,87 @@ func (r *Repo) CancelWorkflow(ctx context.Context, repository string, runID int6
 	return nil
 }
 
+func (r *Repo) ForceCancelWorkflow(ctx context.Context, repository string, runID int64) error {
+	// Force-cancel a given workflow run, it stops the steps which run even if the run is cancelled, like always()
+	err := r.do(ctx, nil, nil, requestOptions{
+		method: http.MethodPost,
+		paths:  []string{"repos", repository, "actions", "runs", strconv.FormatInt(runID, 10), "force-cancel"},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repo) DeleteWorkflowRun(ctx context.Context, repository string, runID int64) error {
+	// Delete a given workflow run with its logs, the run must be completed
+	err := r.do(ctx, nil, nil, requestOptions{
+		method: http.MethodDelete,
+		paths:  []string{"repos", repository, "actions", "runs", strconv.FormatInt(runID, 10)},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repo) DeleteWorkflowRunLogs(ctx context.Context, repository string, runID int64) error {
+	// Delete the logs of a given workflow run, the run itself is kept
+	err := r.do(ctx, nil, nil, requestOptions{
+		method: http.MethodDelete,
+		paths:  []string{"repos", repository, "actions", "runs", strconv.FormatInt(runID, 10), "logs"},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repo) EnableWorkflow(ctx context.Context, repository string, workflowID int64) error {
+	// Enable a given workflow
+	err := r.do(ctx, nil, nil, requestOptions{
+		method: http.MethodPut,
+		paths:  []string{"repos", repository, "actions", "workflows", strconv.FormatInt(workflowID, 10), "enable"},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repo) DisableWorkflow(ctx context.Context, repository string, workflowID int64) error {
+	// Disable a given workflow
+	err := r.do(ctx, nil, nil, requestOptions{
+		method: http.MethodPut,
+		paths:  []string{"repos", repository, "actions", "workflows", strconv.FormatInt(workflowID, 10), "disable"},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repo) CreateRepositoryDispatch(ctx context.Context, repository string, eventType string, clientPayload json.RawMessage) error {
+	// Send a repository_dispatch event, workflows listening for the event type are triggered on the default branch
+	err := r.do(ctx, githubRepositoryDispatch{
+		EventType:     eventType,
+		ClientPayload: clientPayload,
+	}, nil, requestOptions{
+		method: http.MethodPost,
+		paths:  []string{"repos", repository, "dispatches"},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (r *Repo) do(ctx context.Context, requestBody any, responseBody any, requestOptions requestOptions) error {
 	// Construct the request URL
 	reqURL, err := joinPath(append([]string{githubAPIURL}, requestOptions.paths...)...)
@@ -457,8 +827,24 @@ type githubWorkflow struct {
 	Workflows  []Workflow `json:"workflows"`
 }
 
-type workflowFile struct {
-	On map[string]any `yaml:"on"`
+type githubEnvironments struct {
+	TotalCount   int64               `json:"total_count"`
+	Environments []GithubEnvironment `json:"environments"`
+}
+
+type githubRepositoryDispatch struct {
+	EventType     string          `json:"event_type"`
+	ClientPayload json.RawMessage `json:"client_payload,omitempty"`
+}
+
+type githubReRun struct {
+	EnableDebugLogging bool `json:"enable_debug_logging"`
+}
+
+type githubDeploymentReview struct {
+	EnvironmentIDs []int64 `json:"environment_ids"`
+	State          string  `json:"state"`
+	Comment        string  `json:"comment"`
 }
 
 type githubFile struct {
@@ -46,7 +46,7 @@ func TestRepo_ListWorkflowRuns(t *testing.T) {
 
 	targetRepositoryName := "canack/tc"
 
-	workflowRuns, err := repo.ListWorkflowRuns(ctx, targetRepositoryName)
+	workflowRuns, err := repo.ListWorkflowRuns(ctx, targetRepositoryName, WorkflowRunsFilter{})
 	if err != nil {
 		t.Error(err)
 	}
@@ -67,6 +67,26 @@ func TestRepo_GetTriggerableWorkflows(t *testing.T) {
 	t.Log(workflows)
 }
 
+func TestRepo_GetWorkflowsWithTriggers(t *testing.T) {
+	ctx := context.Background()
+
+	repo := newRepo(ctx)
+
+	workflows, err := repo.GetWorkflowsWithTriggers(ctx, "canack/tc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(workflows) == 0 {
+		t.Fatal("expected workflows")
+	}
+	for _, workflow := range workflows {
+		if len(workflow.Triggers.Events) == 0 {
+			t.Errorf("expected the triggers of %s", workflow.Path)
+		}
+	}
+}
+
 func TestRepo_GetAuthUser(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"time"
+
+	py "github.com/termkit/gama/pkg/yaml"
 )
 
 type GithubRepository struct {
@@ -59,9 +61,38 @@ type GithubRepository struct {
 }
 
 type GithubBranch struct {
+	Name      string `json:"name"`
+	Protected bool   `json:"protected"`
+}
+
+type GithubTag struct {
+	Name string `json:"name"`
+}
+
+type GithubEnvironment struct {
+	ID   int64  `json:"id"`
 	Name string `json:"name"`
 }
 
+// PendingDeployment is a deployment of a waiting run to an environment with protection rules
+type PendingDeployment struct {
+	Environment           GithubEnvironment    `json:"environment"`
+	WaitTimer             int                  `json:"wait_timer"` // minutes to wait before the deployment can go on
+	WaitTimerStartedAt    time.Time            `json:"wait_timer_started_at"`
+	CurrentUserCanApprove bool                 `json:"current_user_can_approve"`
+	Reviewers             []DeploymentReviewer `json:"reviewers"`
+}
+
+// DeploymentReviewer is a user or a team who can review a deployment, Type is User or Team
+type DeploymentReviewer struct {
+	Type     string `json:"type"`
+	Reviewer struct {
+		Login string `json:"login"` // login of a user
+		Name  string `json:"name"`  // name of a team
+		Slug  string `json:"slug"`  // slug of a team
+	} `json:"reviewer"`
+}
+
 type Workflow struct {
 	ID        int64     `json:"id"`
 	Name      string    `json:"name"`
@@ -70,6 +101,22 @@ type Workflow struct {
 	UpdatedAt time.Time `json:"updated_at"`
 	Url       string    `json:"url"`
 	HtmlUrl   string    `json:"html_url"`
+
+	// Triggers is parsed from the workflow file, it is only filled by GetWorkflowsWithTriggers and GetTriggerableWorkflows
+	Triggers py.WorkflowTriggers `json:"-"`
+}
+
+// WorkflowRunsFilter narrows the runs listed by ListWorkflowRuns on GitHub, empty fields are not filtered
+type WorkflowRunsFilter struct {
+	Actor  string // login of the user who started the run
+	Branch string
+	Event  string // event which triggered the run, like push or schedule
+	Status string // status or conclusion of the run, like in_progress or failure
+
+	WorkflowID int64  // only the runs of the workflow are listed if it is set
+	Created    string // range of the creation date of the runs, like >=2024-01-31
+	Page       int    // page of the runs starting from 1, GitHub lists up to 1000 runs of a filtered list
+	PerPage    int    // number of runs of a page, up to 100
 }
 
 type WorkflowRuns struct {
@@ -87,9 +134,16 @@ type WorkflowRun struct {
 	Status          string    `json:"status"`
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
+	RunStartedAt    time.Time `json:"run_started_at"`
 	Conclusion      string    `json:"conclusion"`
 	HeadBranch      string    `json:"head_branch"`
+	HeadSHA         string    `json:"head_sha"`
+	HeadCommit      Commit    `json:"head_commit"`
 
+	// PullRequests are the pull requests of the head branch, it is empty for pull requests from forks
+	PullRequests []PullRequest `json:"pull_requests"`
+
+	RunNumber     int    `json:"run_number"`
 	RunAttempt    int    `json:"run_attempt"`
 	CheckSuiteURL string `json:"check_suite_url"`
 	CancelURL     string `json:"cancel_url"`
@@ -102,12 +156,73 @@ type WorkflowRun struct {
 	ArtifactsURL  string `json:"artifacts_url"`
 }
 
+// WorkflowRunTiming is the billable time of a run by the OS of its runners, like UBUNTU, MACOS and WINDOWS.
+// Jobs on self-hosted and larger runners aren't billed here.
+type WorkflowRunTiming struct {
+	Billable      map[string]RunBillable `json:"billable"`
+	RunDurationMS int64                  `json:"run_duration_ms"`
+}
+
+type RunBillable struct {
+	TotalMS int64 `json:"total_ms"`
+	Jobs    int   `json:"jobs"`
+}
+
+// WorkflowTiming is the billable time of a workflow in the current billing cycle by the OS of its runners
+type WorkflowTiming struct {
+	Billable map[string]WorkflowBillable `json:"billable"`
+}
+
+type WorkflowBillable struct {
+	TotalMS int64 `json:"total_ms"`
+}
+
+type WorkflowJobs struct {
+	TotalCount int64         `json:"total_count"`
+	Jobs       []WorkflowJob `json:"jobs"`
+}
+
+type WorkflowJob struct {
+	ID          int64     `json:"id"`
+	RunID       int64     `json:"run_id"`
+	Name        string    `json:"name"`
+	Status      string    `json:"status"`
+	Conclusion  string    `json:"conclusion"`
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+	HTMLURL     string    `json:"html_url"`
+}
+
 type Actor struct {
 	Id        int64  `json:"id"`
 	Login     string `json:"login"`
 	AvatarUrl string `json:"avatar_url"`
 }
 
+type Commit struct {
+	ID        string    `json:"id"`
+	Message   string    `json:"message"`
+	Timestamp time.Time `json:"timestamp"`
+	Author    struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"author"`
+}
+
+type PullRequest struct {
+	ID     int64  `json:"id"`
+	Number int    `json:"number"`
+	URL    string `json:"url"` // API url of the pull request
+	Head   struct {
+		Ref string `json:"ref"`
+		SHA string `json:"sha"`
+	} `json:"head"`
+	Base struct {
+		Ref string `json:"ref"`
+		SHA string `json:"sha"`
+	} `json:"base"`
+}
+
 type GithubUser struct {
 	Login string `json:"login"` // username
 	ID    int    `json:"id"`
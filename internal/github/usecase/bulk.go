@@ -0,0 +1,356 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	gr "github.com/termkit/gama/internal/github/repository"
+)
+
+const (
+	// bulkWorkers is the number of runs acted on at once
+	bulkWorkers = 4
+
+	// maxBulkPages is the number of pages of runs a bulk action is done to at once, GitHub lists up to 1000 runs
+	// of a filtered list
+	maxBulkPages = 10
+)
+
+func (u useCase) CancelWorkflowRuns(ctx context.Context, input CancelWorkflowRunsInput) (*BulkRunsOutput, error) {
+	runIDs := activeRuns(input.Runs)
+	output := forEachRun(ctx, runIDs, func(ctx context.Context, runID int64) error {
+		return u.CancelWorkflow(ctx, CancelWorkflowInput{
+			Repository: input.Repository,
+			WorkflowID: runID,
+			Force:      input.Force,
+		})
+	})
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	output.Skipped = len(input.Runs) - len(runIDs)
+	return output, nil
+}
+
+func (u useCase) ReRunFailedWorkflowRuns(ctx context.Context, input ReRunFailedWorkflowRunsInput) (*BulkRunsOutput, error) {
+	runIDs := failedRuns(input.Runs)
+	output := forEachRun(ctx, runIDs, func(ctx context.Context, runID int64) error {
+		return u.ReRunFailedJobs(ctx, ReRunFailedJobsInput{
+			Repository:         input.Repository,
+			WorkflowID:         runID,
+			EnableDebugLogging: input.EnableDebugLogging,
+		})
+	})
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	output.Skipped = len(input.Runs) - len(runIDs)
+	return output, nil
+}
+
+// CancelQueuedRuns cancels the queued runs of the workflow, not only the listed ones
+func (u useCase) CancelQueuedRuns(ctx context.Context, input CancelQueuedRunsInput) (*BulkRunsOutput, error) {
+	const perPage = 100
+
+	// Cancelled runs leave the list of queued runs, so all the pages are fetched before the runs are cancelled
+	var runIDs []int64
+	for page := 1; page <= maxBulkPages; page++ {
+		workflowRuns, err := u.githubRepository.ListWorkflowRuns(ctx, input.Repository, gr.WorkflowRunsFilter{
+			WorkflowID: input.WorkflowID,
+			Status:     "queued",
+			Page:       page,
+			PerPage:    perPage,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, run := range workflowRuns.WorkflowRuns {
+			runIDs = append(runIDs, run.ID)
+		}
+		if len(workflowRuns.WorkflowRuns) < perPage {
+			break
+		}
+	}
+
+	output := forEachRun(ctx, runIDs, func(ctx context.Context, runID int64) error {
+		return u.githubRepository.CancelWorkflow(ctx, input.Repository, runID)
+	})
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return output, nil
+}
+
+// DeleteOldWorkflowRuns deletes the completed runs of the workflow created more than the days ago with their logs.
+// GitHub lists up to 1000 runs, so the runs are listed and deleted again until no old run is left to delete.
+func (u useCase) DeleteOldWorkflowRuns(ctx context.Context, input DeleteOldWorkflowRunsInput) (*DeleteOldWorkflowRunsOutput, error) {
+	if input.Days <= 0 {
+		return nil, fmt.Errorf("days must be positive, got %d", input.Days)
+	}
+
+	workflow, err := u.getWorkflow(ctx, input.Repository, input.Workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -input.Days)
+	output := DeleteOldWorkflowRunsOutput{Workflow: workflow.Path}
+	if !input.DryRun {
+		output.Deleted = &BulkRunsOutput{}
+	}
+
+	// The runs which failed to be deleted are listed again, they are not deleted twice. The runs which are not
+	// completed are counted once over all the lists.
+	tried := make(map[int64]bool)
+	skipped := make(map[int64]bool)
+	for {
+		runs, unlisted, err := u.listOldRuns(ctx, input.Repository, workflow.ID, cutoff)
+		if err != nil {
+			return nil, err
+		}
+
+		old, skippedRuns := oldRuns(runs, cutoff)
+		for _, runID := range skippedRuns {
+			skipped[runID] = true
+		}
+		output.Remaining = unlisted
+		if input.DryRun {
+			output.Runs = old
+			output.Skipped = len(skipped)
+			return &output, nil
+		}
+
+		var runIDs []int64
+		for _, run := range old {
+			if !tried[run.ID] {
+				tried[run.ID] = true
+				runIDs = append(runIDs, run.ID)
+				output.Runs = append(output.Runs, run)
+			}
+		}
+		if len(runIDs) == 0 {
+			break
+		}
+
+		deleted := forEachRun(ctx, runIDs, func(ctx context.Context, runID int64) error {
+			return u.githubRepository.DeleteWorkflowRun(ctx, input.Repository, runID)
+		})
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		output.Deleted.Runs = append(output.Deleted.Runs, deleted.Runs...)
+		output.Deleted.Failures = append(output.Deleted.Failures, deleted.Failures...)
+
+		// Every old run is listed, the next list would have nothing new to delete
+		if unlisted == 0 {
+			break
+		}
+	}
+
+	// The runs which completed between the lists are deleted, they are not skipped
+	for runID := range tried {
+		delete(skipped, runID)
+	}
+	output.Skipped = len(skipped)
+
+	slices.SortStableFunc(output.Runs, func(a, b OldRun) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	slices.Sort(output.Deleted.Runs)
+	slices.SortFunc(output.Deleted.Failures, func(a, b RunFailure) int {
+		return cmp.Compare(a.RunID, b.RunID)
+	})
+	return &output, nil
+}
+
+// listOldRuns lists the runs of the workflow created up to the day of the cutoff, and the number of the runs which
+// are not listed because GitHub lists up to 1000 runs. Deleted runs leave the list, so all the pages are fetched
+// before the runs are deleted.
+func (u useCase) listOldRuns(ctx context.Context, repository string, workflowID int64, cutoff time.Time) ([]gr.WorkflowRun, int, error) {
+	const perPage = 100
+
+	var runs []gr.WorkflowRun
+	var totalCount int64
+	for page := 1; page <= maxBulkPages; page++ {
+		workflowRuns, err := u.githubRepository.ListWorkflowRuns(ctx, repository, gr.WorkflowRunsFilter{
+			WorkflowID: workflowID,
+			Created:    "<=" + cutoff.UTC().Format("2006-01-02"),
+			Page:       page,
+			PerPage:    perPage,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+
+		runs = append(runs, workflowRuns.WorkflowRuns...)
+		totalCount = workflowRuns.TotalCount
+		if len(workflowRuns.WorkflowRuns) < perPage {
+			break
+		}
+	}
+	return runs, unlistedRuns(totalCount, len(runs)), nil
+}
+
+// unlistedRuns returns the number of the runs which are counted but not listed
+func unlistedRuns(totalCount int64, listed int) int {
+	return max(int(totalCount)-listed, 0)
+}
+
+// getWorkflow gets the workflow by its ID or its file name, or finds it among the workflows by its name
+func (u useCase) getWorkflow(ctx context.Context, repository string, workflow string) (gr.Workflow, error) {
+	workflow = strings.TrimSpace(workflow)
+	if isWorkflowIDOrFile(workflow) {
+		found, err := u.githubRepository.GetWorkflow(ctx, repository, workflow)
+		if err != nil {
+			return gr.Workflow{}, fmt.Errorf("workflow %s: %w", workflow, err)
+		}
+		return *found, nil
+	}
+
+	workflows, err := u.githubRepository.GetWorkflows(ctx, repository)
+	if err != nil {
+		return gr.Workflow{}, err
+	}
+	return findWorkflow(workflows, workflow)
+}
+
+// isWorkflowIDOrFile reports whether the workflow is given by its ID or by its file name or path
+func isWorkflowIDOrFile(workflow string) bool {
+	if _, err := strconv.ParseInt(workflow, 10, 64); err == nil {
+		return true
+	}
+	extension := path.Ext(workflow)
+	return extension == ".yml" || extension == ".yaml"
+}
+
+// findWorkflow finds the workflow by its ID, its path, its file name or its name, names are case-insensitive
+func findWorkflow(workflows []gr.Workflow, workflow string) (gr.Workflow, error) {
+	workflow = strings.TrimSpace(workflow)
+	if workflow == "" {
+		return gr.Workflow{}, fmt.Errorf("no workflow given")
+	}
+
+	var byName []gr.Workflow
+	for _, w := range workflows {
+		if strconv.FormatInt(w.ID, 10) == workflow || w.Path == workflow || path.Base(w.Path) == workflow {
+			return w, nil
+		}
+		if strings.EqualFold(w.Name, workflow) {
+			byName = append(byName, w)
+		}
+	}
+
+	switch len(byName) {
+	case 0:
+		return gr.Workflow{}, fmt.Errorf("workflow %s not found", workflow)
+	case 1:
+		return byName[0], nil
+	default:
+		return gr.Workflow{}, fmt.Errorf("%d workflows are named %s, use the file name of the workflow", len(byName), workflow)
+	}
+}
+
+// oldRuns returns the completed runs created before the cutoff, the oldest first, and the IDs of the runs created
+// before the cutoff which are not completed
+func oldRuns(runs []gr.WorkflowRun, cutoff time.Time) ([]OldRun, []int64) {
+	var old []OldRun
+	var skipped []int64
+	for _, run := range runs {
+		if !run.CreatedAt.Before(cutoff) {
+			continue
+		}
+		if run.Status != "completed" {
+			skipped = append(skipped, run.ID)
+			continue
+		}
+		old = append(old, OldRun{
+			ID:        run.ID,
+			RunNumber: run.RunNumber,
+			Title:     run.DisplayTitle,
+			CreatedAt: run.CreatedAt,
+		})
+	}
+
+	slices.SortStableFunc(old, func(a, b OldRun) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return old, skipped
+}
+
+// activeRuns returns the runs which are not completed, they are the ones which can be cancelled
+func activeRuns(runs []Workflow) []int64 {
+	var runIDs []int64
+	for _, run := range runs {
+		if run.Status != "completed" {
+			runIDs = append(runIDs, run.ID)
+		}
+	}
+	return runIDs
+}
+
+// failedRuns returns the completed runs which failed, they are the ones whose failed jobs can be re-run. Unlike
+// isFailedConclusion, runs with startup_failure are left out, they fail before any job starts so they have no
+// failed jobs to re-run.
+func failedRuns(runs []Workflow) []int64 {
+	var runIDs []int64
+	for _, run := range runs {
+		if run.Status == "completed" && (run.Conclusion == "failure" || run.Conclusion == "timed_out") {
+			runIDs = append(runIDs, run.ID)
+		}
+	}
+	return runIDs
+}
+
+// forEachRun does the action to the runs with a bounded number of workers, the runs and the failures are sorted
+func forEachRun(ctx context.Context, runIDs []int64, action func(ctx context.Context, runID int64) error) *BulkRunsOutput {
+	jobs := make(chan int64)
+	failures := make(chan RunFailure, len(runIDs))
+	done := make(chan int64, len(runIDs))
+
+	var wg sync.WaitGroup
+	for range min(bulkWorkers, len(runIDs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for runID := range jobs {
+				if err := action(ctx, runID); err != nil {
+					failures <- RunFailure{RunID: runID, Err: err}
+					continue
+				}
+				done <- runID
+			}
+		}()
+	}
+
+	for _, runID := range runIDs {
+		jobs <- runID
+	}
+	close(jobs)
+	wg.Wait()
+	close(failures)
+	close(done)
+
+	var output BulkRunsOutput
+	for runID := range done {
+		output.Runs = append(output.Runs, runID)
+	}
+	for failure := range failures {
+		output.Failures = append(output.Failures, failure)
+	}
+
+	slices.Sort(output.Runs)
+	slices.SortFunc(output.Failures, func(a, b RunFailure) int {
+		return cmp.Compare(a.RunID, b.RunID)
+	})
+	return &output
+}
@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"slices"
+	"strings"
+
+	gr "github.com/termkit/gama/internal/github/repository"
+)
+
+// RunFilterKeys are the keys of the structured tokens of a RunFilter
+var RunFilterKeys = []string{"status", "actor", "workflow", "branch", "event"}
+
+// runStatuses are the statuses and conclusions GitHub can filter runs by
+var runStatuses = []string{"completed", "action_required", "cancelled", "failure", "neutral", "skipped", "stale",
+	"success", "timed_out", "in_progress", "queued", "requested", "waiting", "pending"}
+
+// RunFilter filters workflow runs by free text and key:value tokens like status:failure. Every word and every key
+// must match, the values of a key which is given more than once are alternatives.
+type RunFilter struct {
+	Words  []string            // free text, matched against the workflow name, title, actor and branch of a run
+	Tokens map[string][]string // values by key, the keys are the ones of RunFilterKeys
+}
+
+// ParseRunFilter parses a filter query, a token with an unknown key is a word. Values with spaces can be quoted like
+// workflow:"Deploy to production".
+func ParseRunFilter(query string) RunFilter {
+	var filter RunFilter
+	for _, field := range splitQuery(query) {
+		key, value, ok := strings.Cut(field, ":")
+		key = strings.ToLower(key)
+		if !ok || value == "" || !slices.Contains(RunFilterKeys, key) {
+			filter.Words = append(filter.Words, strings.ToLower(strings.Trim(field, `"`)))
+			continue
+		}
+
+		if filter.Tokens == nil {
+			filter.Tokens = make(map[string][]string)
+		}
+		filter.Tokens[key] = append(filter.Tokens[key], strings.Trim(value, `"`))
+	}
+	return filter
+}
+
+// splitQuery splits the query at spaces which are not quoted
+func splitQuery(query string) []string {
+	var fields []string
+	var field strings.Builder
+	var quoted bool
+	for _, r := range query {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			field.WriteRune(r)
+		case r == ' ' && !quoted:
+			if field.Len() > 0 {
+				fields = append(fields, field.String())
+				field.Reset()
+			}
+		default:
+			field.WriteRune(r)
+		}
+	}
+	if field.Len() > 0 {
+		fields = append(fields, field.String())
+	}
+	return fields
+}
+
+func (f RunFilter) IsEmpty() bool {
+	return len(f.Words) == 0 && len(f.Tokens) == 0
+}
+
+// String returns the filter in a normalized form, the tokens come first in the order of RunFilterKeys
+func (f RunFilter) String() string {
+	var fields []string
+	for _, key := range RunFilterKeys {
+		for _, value := range f.Tokens[key] {
+			if strings.Contains(value, " ") {
+				value = `"` + value + `"`
+			}
+			fields = append(fields, key+":"+value)
+		}
+	}
+	return strings.Join(append(fields, f.Words...), " ")
+}
+
+// Match reports whether the run matches the filter. Status matches the status or the conclusion, workflow matches a
+// part of the workflow name or file, the other keys match whole values. Letter case is ignored.
+func (f RunFilter) Match(run Workflow) bool {
+	for _, word := range f.Words {
+		if !slices.ContainsFunc([]string{run.WorkflowName, run.ActionName, run.TriggeredBy, run.Branch}, func(value string) bool {
+			return strings.Contains(strings.ToLower(value), word)
+		}) {
+			return false
+		}
+	}
+
+	for key, values := range f.Tokens {
+		if !slices.ContainsFunc(values, func(value string) bool { return matchRunToken(run, key, value) }) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchRunToken(run Workflow, key string, value string) bool {
+	switch key {
+	case "status":
+		return strings.EqualFold(run.Status, value) || strings.EqualFold(run.Conclusion, value)
+	case "actor":
+		return strings.EqualFold(run.TriggeredBy, value)
+	case "workflow":
+		value = strings.ToLower(value)
+		return strings.Contains(strings.ToLower(run.WorkflowName), value) || strings.Contains(strings.ToLower(run.Path), value)
+	case "branch":
+		return strings.EqualFold(run.Branch, value)
+	case "event":
+		return strings.EqualFold(run.Event, value)
+	}
+	return false
+}
+
+// apiFilter returns the tokens GitHub can filter by, a key is only sent if it has a single value since GitHub
+// doesn't support alternatives. The other tokens and the words are matched on the listed runs.
+func (f RunFilter) apiFilter() gr.WorkflowRunsFilter {
+	single := func(key string) string {
+		if values := f.Tokens[key]; len(values) == 1 {
+			return values[0]
+		}
+		return ""
+	}
+
+	filter := gr.WorkflowRunsFilter{
+		Actor:  single("actor"),
+		Branch: single("branch"),
+		Event:  single("event"),
+	}
+	if status := strings.ToLower(single("status")); slices.Contains(runStatuses, status) {
+		filter.Status = status
+	}
+	return filter
+}
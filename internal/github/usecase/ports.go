@@ -8,11 +8,32 @@ type UseCase interface {
 	GetAuthUser(ctx context.Context) (*GetAuthUserOutput, error)
 	ListRepositories(ctx context.Context, input ListRepositoriesInput) (*ListRepositoriesOutput, error)
 	GetRepositoryBranches(ctx context.Context, input GetRepositoryBranchesInput) (*GetRepositoryBranchesOutput, error)
+	GetRepositoryTags(ctx context.Context, input GetRepositoryTagsInput) (*GetRepositoryTagsOutput, error)
 	GetWorkflowHistory(ctx context.Context, input GetWorkflowHistoryInput) (*GetWorkflowHistoryOutput, error)
+	GetMultiRepositoryHistory(ctx context.Context, input GetMultiRepositoryHistoryInput) (*GetMultiRepositoryHistoryOutput, error)
+	GetWorkflowStatistics(ctx context.Context, input GetWorkflowStatisticsInput) (*GetWorkflowStatisticsOutput, error)
+	GetRepositoryUsage(ctx context.Context, input GetRepositoryUsageInput) (*GetRepositoryUsageOutput, error)
 	GetTriggerableWorkflows(ctx context.Context, input GetTriggerableWorkflowsInput) (*GetTriggerableWorkflowsOutput, error)
+	GetWorkflowsWithTriggers(ctx context.Context, input GetWorkflowsWithTriggersInput) (*GetWorkflowsWithTriggersOutput, error)
 	InspectWorkflow(ctx context.Context, input InspectWorkflowInput) (*InspectWorkflowOutput, error)
+	GetWorkflowFile(ctx context.Context, input GetWorkflowFileInput) (*GetWorkflowFileOutput, error)
+	GetWorkflowRunGraph(ctx context.Context, input GetWorkflowRunGraphInput) (*GetWorkflowRunGraphOutput, error)
+	GetWorkflowRunDetail(ctx context.Context, input GetWorkflowRunDetailInput) (*GetWorkflowRunDetailOutput, error)
 	TriggerWorkflow(ctx context.Context, input TriggerWorkflowInput) error
+	GetPendingDeployments(ctx context.Context, input GetPendingDeploymentsInput) (*GetPendingDeploymentsOutput, error)
+	ReviewPendingDeployments(ctx context.Context, input ReviewPendingDeploymentsInput) (*ReviewPendingDeploymentsOutput, error)
 	ReRunFailedJobs(ctx context.Context, input ReRunFailedJobsInput) error
 	ReRunWorkflow(ctx context.Context, input ReRunWorkflowInput) error
+	ReRunJob(ctx context.Context, input ReRunJobInput) error
 	CancelWorkflow(ctx context.Context, input CancelWorkflowInput) error
+	CancelWorkflowRuns(ctx context.Context, input CancelWorkflowRunsInput) (*BulkRunsOutput, error)
+	ReRunFailedWorkflowRuns(ctx context.Context, input ReRunFailedWorkflowRunsInput) (*BulkRunsOutput, error)
+	CancelQueuedRuns(ctx context.Context, input CancelQueuedRunsInput) (*BulkRunsOutput, error)
+	DeleteWorkflowRun(ctx context.Context, input DeleteWorkflowRunInput) error
+	DeleteWorkflowRunLogs(ctx context.Context, input DeleteWorkflowRunLogsInput) error
+	DeleteOldWorkflowRuns(ctx context.Context, input DeleteOldWorkflowRunsInput) (*DeleteOldWorkflowRunsOutput, error)
+	EnableWorkflow(ctx context.Context, input EnableWorkflowInput) error
+	DisableWorkflow(ctx context.Context, input DisableWorkflowInput) error
+	GetRepositoryDispatchEvents(ctx context.Context, input GetRepositoryDispatchEventsInput) (*GetRepositoryDispatchEventsOutput, error)
+	CreateRepositoryDispatch(ctx context.Context, input CreateRepositoryDispatchInput) error
 }
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"slices"
+	"time"
+
+	gr "github.com/termkit/gama/internal/github/repository"
+)
+
+// maxStatisticsPages is the number of pages of runs fetched for the statistics, GitHub lists up to 1000 runs
+// of a filtered list
+const maxStatisticsPages = 10
+
+func (u useCase) GetWorkflowStatistics(ctx context.Context, input GetWorkflowStatisticsInput) (*GetWorkflowStatisticsOutput, error) {
+	const perPage = 100
+
+	now := time.Now()
+	since := now.AddDate(0, 0, -slices.Max(StatisticsWindows))
+
+	// Fetch the runs of the longest window once, the shorter windows are computed from them
+	var runs []gr.WorkflowRun
+	var totalCount int64
+	for page := 1; page <= maxStatisticsPages; page++ {
+		workflowRuns, err := u.githubRepository.ListWorkflowRuns(ctx, input.Repository, gr.WorkflowRunsFilter{
+			WorkflowID: input.WorkflowID,
+			Created:    ">=" + since.UTC().Format("2006-01-02"),
+			Page:       page,
+			PerPage:    perPage,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		runs = append(runs, workflowRuns.WorkflowRuns...)
+		totalCount = workflowRuns.TotalCount
+		if len(workflowRuns.WorkflowRuns) < perPage {
+			break
+		}
+	}
+
+	var statistics []WorkflowStatistics
+	for _, days := range StatisticsWindows {
+		windowStatistics := workflowStatistics(runs, days, now)
+		windowStatistics.Truncated = isWindowTruncated(runs, totalCount, now.AddDate(0, 0, -days))
+		statistics = append(statistics, windowStatistics)
+	}
+
+	return &GetWorkflowStatisticsOutput{
+		Statistics: statistics,
+	}, nil
+}
+
+// isWindowTruncated reports whether runs created since the time are missing from the listed runs. The runs are
+// listed the latest first, so a window is complete if the listed runs reach past its start.
+func isWindowTruncated(runs []gr.WorkflowRun, totalCount int64, since time.Time) bool {
+	if int64(len(runs)) >= totalCount {
+		return false
+	}
+	for _, run := range runs {
+		if run.CreatedAt.Before(since) {
+			return false
+		}
+	}
+	return true
+}
+
+// workflowStatistics computes the statistics of the runs created in the last days before now.
+// The queue time of a re-run isn't known, since it includes the previous attempts, so only the first attempts are
+// counted in the queue times. Only the succeeded and failed runs are counted in the durations, cancelled and skipped
+// runs stop early.
+func workflowStatistics(runs []gr.WorkflowRun, days int, now time.Time) WorkflowStatistics {
+	since := now.AddDate(0, 0, -days)
+
+	var windowRuns []gr.WorkflowRun
+	for _, run := range runs {
+		if !run.CreatedAt.Before(since) && !run.CreatedAt.After(now) {
+			windowRuns = append(windowRuns, run)
+		}
+	}
+	slices.SortStableFunc(windowRuns, func(a, b gr.WorkflowRun) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	statistics := WorkflowStatistics{
+		Days:              days,
+		Runs:              len(windowRuns),
+		DailySuccessRates: make([]float64, days),
+	}
+	dailySucceeded := make([]int, days)
+	dailyFailed := make([]int, days)
+
+	var streak int
+	for _, run := range windowRuns {
+		day := min(int(run.CreatedAt.Sub(since)/(24*time.Hour)), days-1)
+
+		switch {
+		case run.Conclusion == "success":
+			statistics.Succeeded++
+			dailySucceeded[day]++
+			if run.RunAttempt > 1 {
+				statistics.Flaky++
+			}
+			streak = 0
+		case isFailedConclusion(run.Conclusion):
+			statistics.Failed++
+			dailyFailed[day]++
+			streak++
+			statistics.LongestFailureStreak = max(statistics.LongestFailureStreak, streak)
+		case run.Conclusion == "cancelled" || run.Conclusion == "skipped":
+			statistics.Cancelled++
+		}
+
+		if run.Status != "completed" || run.RunStartedAt.IsZero() {
+			continue
+		}
+		if run.Conclusion == "success" || isFailedConclusion(run.Conclusion) {
+			statistics.Durations = append(statistics.Durations, max(run.UpdatedAt.Sub(run.RunStartedAt), 0))
+		}
+		if run.RunAttempt <= 1 {
+			statistics.QueueTimes = append(statistics.QueueTimes, max(run.RunStartedAt.Sub(run.CreatedAt), 0))
+		}
+	}
+	statistics.CurrentFailureStreak = streak
+
+	if conclusive := statistics.Succeeded + statistics.Failed; conclusive > 0 {
+		statistics.SuccessRate = float64(statistics.Succeeded) / float64(conclusive)
+	}
+	for day := range days {
+		statistics.DailySuccessRates[day] = -1
+		if conclusive := dailySucceeded[day] + dailyFailed[day]; conclusive > 0 {
+			statistics.DailySuccessRates[day] = float64(dailySucceeded[day]) / float64(conclusive)
+		}
+	}
+
+	statistics.DurationP50 = percentile(statistics.Durations, 0.5)
+	statistics.DurationP90 = percentile(statistics.Durations, 0.9)
+	statistics.QueueP50 = percentile(statistics.QueueTimes, 0.5)
+	statistics.QueueP90 = percentile(statistics.QueueTimes, 0.9)
+
+	return statistics
+}
+
+// isFailedConclusion reports whether the run failed, timed out or couldn't start
+func isFailedConclusion(conclusion string) bool {
+	return conclusion == "failure" || conclusion == "timed_out" || conclusion == "startup_failure"
+}
+
+// percentile returns the nearest-rank percentile of the durations, p is between 0 and 1
+func percentile(durations []time.Duration, p float64) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[min(max(rank, 0), len(sorted)-1)]
+}
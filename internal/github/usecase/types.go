@@ -1,10 +1,12 @@
 package usecase
 
 import (
+	"strings"
 	"time"
 
 	"github.com/termkit/gama/internal/github/domain"
 	pw "github.com/termkit/gama/pkg/workflow"
+	py "github.com/termkit/gama/pkg/yaml"
 )
 
 type ListRepositoriesInput struct {
@@ -42,8 +44,23 @@ type GetRepositoryBranchesOutput struct {
 }
 
 type GithubBranch struct {
-	Name      string
-	IsDefault bool
+	Name        string
+	IsDefault   bool
+	IsProtected bool
+}
+
+// ------------------------------------------------------------
+
+type GetRepositoryTagsInput struct {
+	Repository string
+}
+
+type GetRepositoryTagsOutput struct {
+	Tags []GithubTag
+}
+
+type GithubTag struct {
+	Name string
 }
 
 // ------------------------------------------------------------
@@ -76,6 +93,9 @@ type GithubUser struct {
 type GetWorkflowHistoryInput struct {
 	Repository string
 	Branch     string
+
+	// Filter narrows the runs on GitHub as far as it can, the runs still have to be matched with RunFilter.Match
+	Filter RunFilter
 }
 
 type GetWorkflowHistoryOutput struct {
@@ -84,6 +104,7 @@ type GetWorkflowHistoryOutput struct {
 
 type Workflow struct {
 	ID           int64  // workflow id
+	Repository   string // repository of the run like owner/name
 	WorkflowName string // workflow name
 	ActionName   string // commit message
 	TriggeredBy  string // who triggered this workflow
@@ -91,6 +112,43 @@ type Workflow struct {
 	Status       string // workflow's status, like success, failure, etc.
 	Conclusion   string // workflow's conclusion, like success, failure, etc.
 	Duration     string // workflow's duration
+	Branch       string // branch the workflow runs on
+	Event        string // event which triggered the workflow, like push or schedule
+	Path         string // path of the workflow file
+
+	RunNumber       int    // number of the run in its workflow
+	RunAttempt      int    // attempt of the run, it is more than 1 for re-runs
+	HeadSHA         string // commit the workflow runs on
+	TriggeringActor string // who triggered the attempt, it differs from TriggeredBy for re-runs
+	QueuedAt        string // when the run is created, StartedAt is when its latest attempt is started
+	WorkflowID      int64  // id of the workflow file of the run
+}
+
+// ------------------------------------------------------------
+
+type GetMultiRepositoryHistoryInput struct {
+	Repositories  []string // repositories like owner/name
+	Organizations []string // every repository of the organizations is added, archived ones are skipped
+	Workers       int      // number of repositories fetched at once
+}
+
+func (i *GetMultiRepositoryHistoryInput) Prepare() {
+	if i.Workers <= 0 {
+		i.Workers = 4
+	}
+}
+
+type GetMultiRepositoryHistoryOutput struct {
+	Repositories []string   // repositories the runs are fetched from
+	Workflows    []Workflow // runs of all repositories, the latest queued first
+
+	// Failures are the repositories whose runs cannot be fetched, the runs of the others are still returned
+	Failures []RepositoryFailure
+}
+
+type RepositoryFailure struct {
+	Repository string
+	Err        error
 }
 
 // ------------------------------------------------------------
@@ -103,6 +161,196 @@ type InspectWorkflowInput struct {
 
 type InspectWorkflowOutput struct {
 	Workflow *pw.Pretty
+	Jobs     []py.WorkflowJob // jobs of the workflow to preview what the run would run
+}
+
+// ------------------------------------------------------------
+
+type GetWorkflowFileInput struct {
+	Repository   string
+	Branch       string // branch, tag or any other git ref
+	WorkflowFile string
+}
+
+type GetWorkflowFileOutput struct {
+	Content string           // raw YAML of the workflow file
+	Jobs    []py.WorkflowJob // jobs outline in declaration order
+	Graph   *pw.Graph        // dependency graph of the jobs
+
+	// Findings are the problems found by linting the file, they are sorted by line
+	Findings []py.Finding
+
+	// ParseError is set if the content is not a valid workflow, the content is still returned
+	ParseError error
+}
+
+// ------------------------------------------------------------
+
+type GetWorkflowRunGraphInput struct {
+	Repository string
+	RunID      int64
+}
+
+type GetWorkflowRunGraphOutput struct {
+	WorkflowName string
+	Path         string // path of the workflow file
+	Ref          string // commit the workflow file is read at, the head commit of the run
+	Graph        *pw.Graph
+
+	// JobStates is a map of job id and the state of the job in the run, the conclusion of a completed job or
+	// the status of a job which is not completed yet. Jobs which did not run are missing.
+	JobStates map[string]string
+
+	// Jobs are the jobs of the run as GitHub names them, a job of a matrix is listed once per combination
+	Jobs []RunJob
+}
+
+type RunJob struct {
+	ID    int64
+	Name  string
+	State string // conclusion of a completed job or the status of a job which is not completed yet
+}
+
+// ------------------------------------------------------------
+
+type GetWorkflowRunDetailInput struct {
+	Repository string
+	RunID      int64
+	Attempt    int // attempt of the run, 0 for its latest attempt
+}
+
+type GetWorkflowRunDetailOutput struct {
+	RunDetail
+}
+
+type RunDetail struct {
+	ID           int64
+	WorkflowName string
+	Path         string // path of the workflow file
+	URL          string // web page of the attempt
+	RunNumber    int
+	Attempt      int // attempt of the detail
+	Attempts     int // number of attempts of the run, the latest attempt
+
+	Status     string
+	Conclusion string
+	Event      string
+
+	HeadBranch    string
+	HeadSHA       string
+	CommitMessage string // first line of the message of the head commit
+	CommitAuthor  string
+
+	Actor           string // who triggered the run
+	TriggeringActor string // who triggered the attempt, it differs from Actor for re-runs by someone else
+	PullRequests    []RunPullRequest
+
+	QueuedAt   string // when the run is created
+	StartedAt  string // when the attempt is started
+	FinishedAt string // when the attempt is completed, it is empty while the attempt is not completed
+	Duration   string // duration of the attempt until now or until it is completed
+
+	// RunDuration and Billable are the timing of the run with all its attempts, they are empty if GitHub
+	// doesn't report the timing
+	RunDuration string
+	Billable    []BillableTime
+}
+
+// BillableTime is the time billed for the jobs of an OS, jobs on self-hosted runners aren't billed
+type BillableTime struct {
+	OS         string // Ubuntu, Windows or macOS
+	Duration   time.Duration
+	Jobs       int
+	Minutes    int // minutes counted against the included minutes, the rounded up duration times the multiplier
+	Multiplier int // minutes of the OS count this many times, like 10 for macOS
+}
+
+type RunPullRequest struct {
+	Number  int
+	URL     string // web page of the pull request
+	HeadRef string
+	BaseRef string
+}
+
+// ------------------------------------------------------------
+
+type GetRepositoryUsageInput struct {
+	Repository string
+}
+
+type GetRepositoryUsageOutput struct {
+	// Workflows are the workflows of the repository, the one which uses the most minutes first
+	Workflows []WorkflowUsage
+
+	// Minutes are the minutes of all workflows counted against the included minutes
+	Minutes int
+
+	// Failures are the workflows whose timing cannot be fetched, the usage of the others is still returned
+	Failures []WorkflowFailure
+}
+
+type WorkflowFailure struct {
+	Path string // path of the workflow file
+	Err  error
+}
+
+// WorkflowUsage is the billable time of a workflow in the current billing cycle
+type WorkflowUsage struct {
+	ID      int64
+	Name    string
+	Path    string
+	State   string
+	Ubuntu  time.Duration
+	Windows time.Duration
+	MacOS   time.Duration
+	Minutes int     // minutes counted against the included minutes, the multipliers of the OSes are applied
+	Share   float64 // share of the minutes of the repository, from 0 to 1
+}
+
+// ------------------------------------------------------------
+
+// StatisticsWindows are the numbers of days the statistics of a workflow are computed for
+var StatisticsWindows = []int{7, 30, 90}
+
+type GetWorkflowStatisticsInput struct {
+	Repository string
+	WorkflowID int64
+}
+
+type GetWorkflowStatisticsOutput struct {
+	// Statistics are the statistics of the workflow in the last days of StatisticsWindows, in the same order
+	Statistics []WorkflowStatistics
+}
+
+type WorkflowStatistics struct {
+	Days int // the runs created in the last days are counted
+
+	Runs      int // all runs, including the ones not completed yet
+	Succeeded int
+	Failed    int // runs which failed, timed out or couldn't start
+	Cancelled int // runs which are cancelled or skipped, they aren't counted in the success rate
+	Flaky     int // runs which succeeded after a re-run
+
+	SuccessRate float64 // succeeded runs of the succeeded and failed runs, from 0 to 1
+
+	DurationP50 time.Duration // durations of the succeeded and failed runs from their start to their end
+	DurationP90 time.Duration
+	QueueP50    time.Duration // waits of the runs from their creation to their start
+	QueueP90    time.Duration
+
+	LongestFailureStreak int // most failed runs in a row
+	CurrentFailureStreak int // failed runs in a row since the latest succeeded run
+
+	// Durations and QueueTimes are the series of the runs in DurationP50 and QueueP50, the oldest run first
+	Durations  []time.Duration
+	QueueTimes []time.Duration
+
+	// Truncated is set if the oldest runs of the window aren't counted, GitHub lists up to 1000 runs
+	Truncated bool
+
+	// DailySuccessRates are the success rates of the days, the oldest day first. It is -1 for a day without
+	// succeeded or failed runs.
+	DailySuccessRates []float64
 }
 
 // ------------------------------------------------------------
@@ -110,7 +358,7 @@ type InspectWorkflowOutput struct {
 type TriggerWorkflowInput struct {
 	WorkflowFile string
 	Repository   string
-	Branch       string
+	Branch       string // branch, tag or any other git ref
 	Content      string // workflow content in json format
 }
 
@@ -126,28 +374,212 @@ type GetTriggerableWorkflowsOutput struct {
 }
 
 type TriggerableWorkflow struct {
-	ID   int64
-	Name string
-	Path string
+	ID    int64
+	Name  string
+	Path  string
+	State string // active, disabled_manually, disabled_inactivity, etc.
+}
+
+// ------------------------------------------------------------
+
+type GetWorkflowsWithTriggersInput struct {
+	Repository string
+}
+
+type GetWorkflowsWithTriggersOutput struct {
+	Workflows []WorkflowWithTriggers
+}
+
+type WorkflowWithTriggers struct {
+	ID           int64
+	Name         string
+	Path         string
+	State        string
+	Triggers     []string // trigger events, schedules come with their humanized cron like "schedule (at 05:30 UTC every day)"
+	Dispatchable bool     // true if the workflow can be triggered by "workflow_dispatch"
+}
+
+// ------------------------------------------------------------
+
+type GetPendingDeploymentsInput struct {
+	Repository string
+	RunID      int64
+}
+
+type GetPendingDeploymentsOutput struct {
+	Deployments []PendingDeployment
+}
+
+// PendingDeployment is a deployment of a waiting run which waits for a review of its environment
+type PendingDeployment struct {
+	EnvironmentID int64
+	Environment   string
+	CanApprove    bool     // the authenticated user is a reviewer of the environment
+	Reviewers     []string // logins of the users and slugs of the teams who can review the deployment
+	WaitTimer     int      // minutes to wait before the deployment can go on, 0 without a wait timer
+	WaitUntil     string   // when the wait timer ends, empty without a wait timer
+}
+
+// ------------------------------------------------------------
+
+type ReviewPendingDeploymentsInput struct {
+	Repository string
+	RunID      int64
+	Approve    bool   // the deployments are rejected if it is false
+	Comment    string // comment of the review, a default one is used if it is empty
+}
+
+func (i *ReviewPendingDeploymentsInput) Prepare() {
+	i.Comment = strings.TrimSpace(i.Comment)
+	if i.Comment != "" {
+		return
+	}
+	if i.Approve {
+		i.Comment = "Approved from gama"
+	} else {
+		i.Comment = "Rejected from gama"
+	}
+}
+
+type ReviewPendingDeploymentsOutput struct {
+	Environments []string // environments whose deployments are reviewed
 }
 
 // ------------------------------------------------------------
 
 type ReRunFailedJobsInput struct {
+	Repository         string
+	WorkflowID         int64
+	EnableDebugLogging bool // the re-run writes runner and step debug logs
+}
+
+// ------------------------------------------------------------
+
+type ReRunWorkflowInput struct {
+	Repository         string
+	WorkflowID         int64
+	EnableDebugLogging bool // the re-run writes runner and step debug logs
+}
+
+// ------------------------------------------------------------
+
+type ReRunJobInput struct {
+	Repository         string
+	JobID              int64
+	EnableDebugLogging bool // the re-run writes runner and step debug logs
+}
+
+// ------------------------------------------------------------
+
+type CancelWorkflowInput struct {
 	Repository string
 	WorkflowID int64
+	Force      bool // force-cancels the run, it stops the steps which run even if the run is cancelled, like always()
 }
 
 // ------------------------------------------------------------
 
-type ReRunWorkflowInput struct {
+type CancelWorkflowRunsInput struct {
+	Repository string
+	Runs       []Workflow // only the runs which are not completed are cancelled
+	Force      bool
+}
+
+type ReRunFailedWorkflowRunsInput struct {
+	Repository         string
+	Runs               []Workflow // only the failed runs are re-run
+	EnableDebugLogging bool
+}
+
+type CancelQueuedRunsInput struct {
 	Repository string
 	WorkflowID int64
 }
 
+// BulkRunsOutput is the result of an action done to many runs, a failing run doesn't stop the others
+type BulkRunsOutput struct {
+	Runs     []int64 // runs the action is done to
+	Skipped  int     // runs the action doesn't apply to
+	Failures []RunFailure
+}
+
+type RunFailure struct {
+	RunID int64
+	Err   error
+}
+
 // ------------------------------------------------------------
 
-type CancelWorkflowInput struct {
+type DeleteWorkflowRunInput struct {
+	Repository string
+	RunID      int64
+}
+
+type DeleteWorkflowRunLogsInput struct {
+	Repository string
+	RunID      int64
+}
+
+type DeleteOldWorkflowRunsInput struct {
+	Repository string
+	Workflow   string // ID, file name or name of the workflow
+	Days       int    // runs created more than this many days ago are deleted
+	DryRun     bool   // the runs are listed but not deleted
+}
+
+type DeleteOldWorkflowRunsOutput struct {
+	Workflow string   // path of the workflow file
+	Runs     []OldRun // completed runs created before the cutoff, the runs which are not completed are skipped
+	Skipped  int
+	// Remaining is the number of the runs created up to the cutoff day which are not listed, GitHub lists up to 1000
+	// runs. A dry run lists only the first 1000, a deletion leaves them if the runs it lists cannot be deleted.
+	Remaining int
+	Deleted   *BulkRunsOutput // nil in a dry run
+}
+
+type OldRun struct {
+	ID        int64
+	RunNumber int
+	Title     string
+	CreatedAt time.Time
+}
+
+// ------------------------------------------------------------
+
+type EnableWorkflowInput struct {
 	Repository string
 	WorkflowID int64
 }
+
+// ------------------------------------------------------------
+
+type DisableWorkflowInput struct {
+	Repository string
+	WorkflowID int64
+}
+
+// ------------------------------------------------------------
+
+type GetRepositoryDispatchEventsInput struct {
+	Repository string
+}
+
+type GetRepositoryDispatchEventsOutput struct {
+	EventTypes []RepositoryDispatchEventType
+
+	// CatchAllWorkflows listen for repository_dispatch without types, they run for every event type
+	CatchAllWorkflows []string
+}
+
+type RepositoryDispatchEventType struct {
+	Name      string
+	Workflows []string // names of the workflows listening for the event type
+}
+
+// ------------------------------------------------------------
+
+type CreateRepositoryDispatchInput struct {
+	Repository    string
+	EventType     string
+	ClientPayload string // JSON object, it can be empty
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	gr "github.com/termkit/gama/internal/github/repository"
+)
+
+// usageWorkers is the number of workflows whose timing is fetched at once
+const usageWorkers = 4
+
+// runnerOS is an OS of the GitHub-hosted runners, the minutes of an OS count as many times as its multiplier
+type runnerOS struct {
+	key        string // key of the OS in the timing of GitHub
+	name       string
+	multiplier int
+}
+
+var runnerOSes = []runnerOS{
+	{key: "UBUNTU", name: "Ubuntu", multiplier: 1},
+	{key: "WINDOWS", name: "Windows", multiplier: 2},
+	{key: "MACOS", name: "macOS", multiplier: 10},
+}
+
+func (u useCase) GetRepositoryUsage(ctx context.Context, input GetRepositoryUsageInput) (*GetRepositoryUsageOutput, error) {
+	workflows, err := u.githubRepository.GetWorkflows(ctx, input.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the timings with a bounded number of workers, a failing workflow doesn't stop the others
+	type workflowTiming struct {
+		workflow gr.Workflow
+		usage    WorkflowUsage
+		err      error
+	}
+
+	jobs := make(chan gr.Workflow)
+	results := make(chan workflowTiming, len(workflows))
+
+	var wg sync.WaitGroup
+	for range min(usageWorkers, len(workflows)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for workflow := range jobs {
+				timing, err := u.githubRepository.GetWorkflowTiming(ctx, input.Repository, workflow.ID)
+				if err != nil {
+					results <- workflowTiming{workflow: workflow, err: err}
+					continue
+				}
+				results <- workflowTiming{workflow: workflow, usage: workflowUsage(workflow, timing.Billable)}
+			}
+		}()
+	}
+
+	for _, workflow := range workflows {
+		jobs <- workflow
+	}
+	close(jobs)
+	wg.Wait()
+	close(results)
+
+	var output GetRepositoryUsageOutput
+	for result := range results {
+		if result.err != nil {
+			output.Failures = append(output.Failures, WorkflowFailure{Path: result.workflow.Path, Err: result.err})
+			continue
+		}
+		output.Workflows = append(output.Workflows, result.usage)
+		output.Minutes += result.usage.Minutes
+	}
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	slices.SortFunc(output.Failures, func(a, b WorkflowFailure) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	for i := range output.Workflows {
+		if output.Minutes > 0 {
+			output.Workflows[i].Share = float64(output.Workflows[i].Minutes) / float64(output.Minutes)
+		}
+	}
+
+	sortWorkflowUsages(output.Workflows)
+	return &output, nil
+}
+
+// billableTimes returns the billable times of a run in the order of runnerOSes, the OSes without jobs are skipped
+func billableTimes(billable map[string]gr.RunBillable) []BillableTime {
+	var times []BillableTime
+	for _, os := range runnerOSes {
+		timing, ok := billable[os.key]
+		if !ok || (timing.TotalMS == 0 && timing.Jobs == 0) {
+			continue
+		}
+		duration := time.Duration(timing.TotalMS) * time.Millisecond
+		times = append(times, BillableTime{
+			OS:         os.name,
+			Duration:   duration,
+			Jobs:       timing.Jobs,
+			Minutes:    billableMinutes(duration, os.multiplier),
+			Multiplier: os.multiplier,
+		})
+	}
+	return times
+}
+
+func workflowUsage(workflow gr.Workflow, billable map[string]gr.WorkflowBillable) WorkflowUsage {
+	usage := WorkflowUsage{
+		ID:    workflow.ID,
+		Name:  workflow.Name,
+		Path:  workflow.Path,
+		State: workflow.State,
+	}
+
+	for _, os := range runnerOSes {
+		duration := time.Duration(billable[os.key].TotalMS) * time.Millisecond
+		switch os.key {
+		case "UBUNTU":
+			usage.Ubuntu = duration
+		case "WINDOWS":
+			usage.Windows = duration
+		case "MACOS":
+			usage.MacOS = duration
+		}
+		usage.Minutes += billableMinutes(duration, os.multiplier)
+	}
+	return usage
+}
+
+// billableMinutes rounds the duration up to minutes and applies the multiplier. GitHub rounds up every job, so the
+// minutes of many jobs can be a little more than the rounded total.
+func billableMinutes(duration time.Duration, multiplier int) int {
+	minutes := int(duration / time.Minute)
+	if duration%time.Minute > 0 {
+		minutes++
+	}
+	return minutes * multiplier
+}
+
+// sortWorkflowUsages sorts the workflows by their minutes, the workflows with the same minutes by their names
+func sortWorkflowUsages(usages []WorkflowUsage) {
+	slices.SortFunc(usages, func(a, b WorkflowUsage) int {
+		if c := cmp.Compare(b.Minutes, a.Minutes); c != 0 {
+			return c
+		}
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+}
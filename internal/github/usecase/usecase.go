@@ -2,12 +2,16 @@ package usecase
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	gr "github.com/termkit/gama/internal/github/repository"
+	"github.com/termkit/gama/pkg/cron"
 	pw "github.com/termkit/gama/pkg/workflow"
 	py "github.com/termkit/gama/pkg/yaml"
 )
@@ -93,6 +97,7 @@ func (u useCase) GetRepositoryBranches(ctx context.Context, input GetRepositoryB
 		return &GetRepositoryBranchesOutput{}, nil
 	}
 
+	// Default branch comes first
 	var result = []GithubBranch{
 		{
 			Name:      mainBranch,
@@ -101,8 +106,14 @@ func (u useCase) GetRepositoryBranches(ctx context.Context, input GetRepositoryB
 	}
 
 	for _, branch := range branches {
+		if branch.Name == mainBranch {
+			result[0].IsProtected = branch.Protected
+			continue
+		}
+
 		result = append(result, GithubBranch{
-			Name: branch.Name,
+			Name:        branch.Name,
+			IsProtected: branch.Protected,
 		})
 	}
 
@@ -111,6 +122,24 @@ func (u useCase) GetRepositoryBranches(ctx context.Context, input GetRepositoryB
 	}, nil
 }
 
+func (u useCase) GetRepositoryTags(ctx context.Context, input GetRepositoryTagsInput) (*GetRepositoryTagsOutput, error) {
+	tags, err := u.githubRepository.ListTags(ctx, input.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []GithubTag
+	for _, tag := range tags {
+		result = append(result, GithubTag{
+			Name: tag.Name,
+		})
+	}
+
+	return &GetRepositoryTagsOutput{
+		Tags: result,
+	}, nil
+}
+
 func (u useCase) workerListRepositories(ctx context.Context, repository gr.GithubRepository, results chan<- GithubRepository, errs chan<- error) {
 	getWorkflows, err := u.githubRepository.GetWorkflows(ctx, repository.FullName)
 	if err != nil {
@@ -138,22 +167,37 @@ func (u useCase) workerListRepositories(ctx context.Context, repository gr.Githu
 func (u useCase) GetWorkflowHistory(ctx context.Context, input GetWorkflowHistoryInput) (*GetWorkflowHistoryOutput, error) {
 	var targetRepositoryName = input.Repository
 
-	workflowRuns, err := u.githubRepository.ListWorkflowRuns(ctx, targetRepositoryName)
+	workflowRuns, err := u.githubRepository.ListWorkflowRuns(ctx, targetRepositoryName, input.Filter.apiFilter())
 	if err != nil {
 		return nil, err
 	}
 
 	var workflows []Workflow
 	for _, workflowRun := range workflowRuns.WorkflowRuns {
+		startedAt := workflowRun.RunStartedAt
+		if startedAt.IsZero() {
+			startedAt = workflowRun.CreatedAt
+		}
+
 		workflows = append(workflows, Workflow{
-			ID:           workflowRun.ID,
-			WorkflowName: workflowRun.Name,
-			ActionName:   workflowRun.DisplayTitle,
-			TriggeredBy:  workflowRun.Actor.Login,
-			StartedAt:    u.timeToString(workflowRun.CreatedAt),
-			Status:       workflowRun.Status,
-			Conclusion:   workflowRun.Conclusion,
-			Duration:     u.getDuration(workflowRun.CreatedAt, workflowRun.UpdatedAt, workflowRun.Status),
+			ID:              workflowRun.ID,
+			Repository:      targetRepositoryName,
+			WorkflowName:    workflowRun.Name,
+			ActionName:      workflowRun.DisplayTitle,
+			TriggeredBy:     workflowRun.Actor.Login,
+			StartedAt:       u.timeToString(startedAt),
+			Status:          workflowRun.Status,
+			Conclusion:      workflowRun.Conclusion,
+			Duration:        u.getDuration(startedAt, workflowRun.UpdatedAt, workflowRun.Status),
+			Branch:          workflowRun.HeadBranch,
+			Event:           workflowRun.Event,
+			Path:            workflowRun.Path,
+			RunNumber:       workflowRun.RunNumber,
+			RunAttempt:      workflowRun.RunAttempt,
+			HeadSHA:         workflowRun.HeadSHA,
+			TriggeringActor: workflowRun.TriggeringActor.Login,
+			QueuedAt:        u.timeToString(workflowRun.CreatedAt),
+			WorkflowID:      workflowRun.WorkflowID,
 		})
 	}
 
@@ -162,6 +206,113 @@ func (u useCase) GetWorkflowHistory(ctx context.Context, input GetWorkflowHistor
 	}, nil
 }
 
+func (u useCase) GetMultiRepositoryHistory(ctx context.Context, input GetMultiRepositoryHistoryInput) (*GetMultiRepositoryHistoryOutput, error) {
+	input.Prepare()
+
+	repositories := slices.Clone(input.Repositories)
+	for _, organization := range input.Organizations {
+		organizationRepositories, err := u.githubRepository.ListOrganizationRepositories(ctx, organization)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories of %s: %w", organization, err)
+		}
+		for _, repository := range organizationRepositories {
+			if !repository.Archived {
+				repositories = append(repositories, repository.FullName)
+			}
+		}
+	}
+	repositories = uniqueRepositories(repositories)
+
+	// Fetch the runs with a bounded number of workers, a failing repository doesn't stop the others
+	type repositoryHistory struct {
+		repository string
+		workflows  []Workflow
+		err        error
+	}
+
+	jobs := make(chan string)
+	results := make(chan repositoryHistory, len(repositories))
+
+	var wg sync.WaitGroup
+	for range min(input.Workers, len(repositories)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for repository := range jobs {
+				history, err := u.GetWorkflowHistory(ctx, GetWorkflowHistoryInput{Repository: repository})
+				if err != nil {
+					results <- repositoryHistory{repository: repository, err: err}
+					continue
+				}
+				results <- repositoryHistory{repository: repository, workflows: history.Workflows}
+			}
+		}()
+	}
+
+	for _, repository := range repositories {
+		jobs <- repository
+	}
+	close(jobs)
+	wg.Wait()
+	close(results)
+
+	var histories [][]Workflow
+	var failures []RepositoryFailure
+	for result := range results {
+		if result.err != nil {
+			failures = append(failures, RepositoryFailure{Repository: result.repository, Err: result.err})
+			continue
+		}
+		histories = append(histories, result.workflows)
+	}
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	slices.SortFunc(failures, func(a, b RepositoryFailure) int {
+		return strings.Compare(a.Repository, b.Repository)
+	})
+
+	return &GetMultiRepositoryHistoryOutput{
+		Repositories: repositories,
+		Workflows:    mergeWorkflowHistories(histories),
+		Failures:     failures,
+	}, nil
+}
+
+// uniqueRepositories drops the empty and repeated repositories, names of repositories are case-insensitive
+func uniqueRepositories(repositories []string) []string {
+	var unique []string
+	for _, repository := range repositories {
+		repository = strings.TrimSpace(repository)
+		if repository == "" {
+			continue
+		}
+		if !slices.ContainsFunc(unique, func(r string) bool { return strings.EqualFold(r, repository) }) {
+			unique = append(unique, repository)
+		}
+	}
+	return unique
+}
+
+// mergeWorkflowHistories merges the runs of repositories chronologically, the latest queued run comes first.
+// Runs queued at the same time are ordered by their repositories.
+func mergeWorkflowHistories(histories [][]Workflow) []Workflow {
+	var workflows []Workflow
+	for _, history := range histories {
+		workflows = append(workflows, history...)
+	}
+
+	slices.SortStableFunc(workflows, func(a, b Workflow) int {
+		if c := strings.Compare(b.QueuedAt, a.QueuedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Repository, b.Repository)
+	})
+	return workflows
+}
+
 func (u useCase) GetTriggerableWorkflows(ctx context.Context, input GetTriggerableWorkflowsInput) (*GetTriggerableWorkflowsOutput, error) {
 	// TODO: Add branch option
 	triggerableWorkflows, err := u.githubRepository.GetTriggerableWorkflows(ctx, input.Repository)
@@ -172,9 +323,10 @@ func (u useCase) GetTriggerableWorkflows(ctx context.Context, input GetTriggerab
 	var workflows []TriggerableWorkflow
 	for _, workflow := range triggerableWorkflows {
 		workflows = append(workflows, TriggerableWorkflow{
-			ID:   workflow.ID,
-			Name: workflow.Name,
-			Path: workflow.Path,
+			ID:    workflow.ID,
+			Name:  workflow.Name,
+			Path:  workflow.Path,
+			State: workflow.State,
 		})
 	}
 
@@ -183,6 +335,50 @@ func (u useCase) GetTriggerableWorkflows(ctx context.Context, input GetTriggerab
 	}, nil
 }
 
+// GetWorkflowsWithTriggers lists the workflows with the triggers of their files on the default branch
+func (u useCase) GetWorkflowsWithTriggers(ctx context.Context, input GetWorkflowsWithTriggersInput) (*GetWorkflowsWithTriggersOutput, error) {
+	githubWorkflows, err := u.githubRepository.GetWorkflowsWithTriggers(ctx, input.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	var workflows []WorkflowWithTriggers
+	for _, workflow := range githubWorkflows {
+		workflows = append(workflows, WorkflowWithTriggers{
+			ID:           workflow.ID,
+			Name:         workflow.Name,
+			Path:         workflow.Path,
+			State:        workflow.State,
+			Triggers:     u.describeTriggers(workflow.Triggers),
+			Dispatchable: workflow.Triggers.Has("workflow_dispatch"),
+		})
+	}
+
+	return &GetWorkflowsWithTriggersOutput{
+		Workflows: workflows,
+	}, nil
+}
+
+// describeTriggers lists the trigger events, each schedule is described by its cron expression
+func (u useCase) describeTriggers(triggers py.WorkflowTriggers) []string {
+	var result []string
+	for _, event := range triggers.Events {
+		if event != "schedule" || len(triggers.Schedules) == 0 {
+			result = append(result, event)
+			continue
+		}
+
+		for _, schedule := range triggers.Schedules {
+			description, err := cron.Describe(schedule)
+			if err != nil {
+				description = schedule
+			}
+			result = append(result, fmt.Sprintf("schedule (%s)", description))
+		}
+	}
+	return result
+}
+
 func (u useCase) InspectWorkflow(ctx context.Context, input InspectWorkflowInput) (*InspectWorkflowOutput, error) {
 	workflowData, err := u.githubRepository.InspectWorkflowContent(ctx, input.Repository, input.Branch, input.WorkflowFile)
 	if err != nil {
@@ -201,27 +397,449 @@ func (u useCase) InspectWorkflow(ctx context.Context, input InspectWorkflowInput
 
 	pretty := workflow.ToPretty()
 
+	if pretty.HasEnvironments() {
+		// Environment inputs fall back to free text if environments cannot be listed, e.g. missing token permission
+		var environmentNames []string
+		environments, err := u.githubRepository.ListEnvironments(ctx, input.Repository)
+		if errors.Is(err, context.Canceled) {
+			return nil, err
+		} else if err == nil {
+			for _, environment := range environments {
+				environmentNames = append(environmentNames, environment.Name)
+			}
+		}
+		pretty.SetEnvironments(environmentNames)
+	}
+
 	return &InspectWorkflowOutput{
 		Workflow: pretty,
+		Jobs:     workflowContent.Jobs,
+	}, nil
+}
+
+func (u useCase) GetWorkflowFile(ctx context.Context, input GetWorkflowFileInput) (*GetWorkflowFileOutput, error) {
+	workflowData, err := u.githubRepository.InspectWorkflowContent(ctx, input.Repository, input.Branch, input.WorkflowFile)
+	if err != nil {
+		return nil, err
+	}
+
+	output := GetWorkflowFileOutput{
+		Content:  string(workflowData),
+		Findings: pw.Lint(workflowData),
+	}
+
+	workflowContent, err := py.UnmarshalWorkflowContent(workflowData)
+	if err != nil {
+		output.ParseError = err
+		return &output, nil
+	}
+	output.Jobs = workflowContent.Jobs
+
+	graph, err := pw.NewGraph(workflowContent.Jobs)
+	if err != nil {
+		output.ParseError = err
+		return &output, nil
+	}
+	output.Graph = graph
+
+	return &output, nil
+}
+
+func (u useCase) GetWorkflowRunGraph(ctx context.Context, input GetWorkflowRunGraphInput) (*GetWorkflowRunGraphOutput, error) {
+	workflowRun, err := u.githubRepository.GetWorkflowRun(ctx, input.Repository, input.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The path of a run of a reusable workflow ends with the ref it is called at
+	path, _, _ := strings.Cut(workflowRun.Path, "@")
+
+	// The graph is built from the workflow file the run is started from, it can differ from the latest one
+	workflowData, err := u.githubRepository.InspectWorkflowContent(ctx, input.Repository, workflowRun.HeadSHA, path)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowContent, err := py.UnmarshalWorkflowContent(workflowData)
+	if err != nil {
+		return nil, err
+	}
+
+	graph, err := pw.NewGraph(workflowContent.Jobs)
+	if err != nil {
+		return nil, err
+	}
+
+	runJobs, err := u.githubRepository.ListWorkflowRunJobs(ctx, input.Repository, input.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetWorkflowRunGraphOutput{
+		WorkflowName: workflowRun.Name,
+		Path:         path,
+		Ref:          workflowRun.HeadSHA,
+		Graph:        graph,
+		JobStates:    jobStates(workflowContent.Jobs, runJobs),
+		Jobs:         runJobList(runJobs),
 	}, nil
 }
 
+func (u useCase) GetWorkflowRunDetail(ctx context.Context, input GetWorkflowRunDetailInput) (*GetWorkflowRunDetailOutput, error) {
+	workflowRun, err := u.githubRepository.GetWorkflowRun(ctx, input.Repository, input.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := workflowRun.RunAttempt
+	if input.Attempt > 0 && input.Attempt != attempts {
+		if input.Attempt > attempts {
+			return nil, fmt.Errorf("run %d has %d attempts, attempt %d does not exist", input.RunID, attempts, input.Attempt)
+		}
+		workflowRun, err = u.githubRepository.GetWorkflowRunAttempt(ctx, input.Repository, input.RunID, input.Attempt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	detail := u.runDetail(input.Repository, *workflowRun, attempts)
+
+	// The timing isn't reported for every run, the detail is still shown without it
+	if timing, err := u.githubRepository.GetWorkflowRunTiming(ctx, input.Repository, input.RunID); err == nil {
+		detail.Billable = billableTimes(timing.Billable)
+		if timing.RunDurationMS > 0 {
+			detail.RunDuration = (time.Duration(timing.RunDurationMS) * time.Millisecond).Round(time.Second).String()
+		}
+	}
+
+	return &GetWorkflowRunDetailOutput{
+		RunDetail: detail,
+	}, nil
+}
+
+func (u useCase) runDetail(repository string, run gr.WorkflowRun, attempts int) RunDetail {
+	commitMessage, _, _ := strings.Cut(run.HeadCommit.Message, "\n")
+
+	detail := RunDetail{
+		ID:              run.ID,
+		WorkflowName:    run.Name,
+		Path:            run.Path,
+		URL:             run.HTMLURL,
+		RunNumber:       run.RunNumber,
+		Attempt:         run.RunAttempt,
+		Attempts:        attempts,
+		Status:          run.Status,
+		Conclusion:      run.Conclusion,
+		Event:           run.Event,
+		HeadBranch:      run.HeadBranch,
+		HeadSHA:         run.HeadSHA,
+		CommitMessage:   commitMessage,
+		CommitAuthor:    run.HeadCommit.Author.Name,
+		Actor:           run.Actor.Login,
+		TriggeringActor: run.TriggeringActor.Login,
+		QueuedAt:        u.timeToString(run.CreatedAt),
+	}
+
+	startedAt := run.RunStartedAt
+	if startedAt.IsZero() {
+		startedAt = run.CreatedAt
+	}
+	detail.StartedAt = u.timeToString(startedAt)
+	detail.Duration = u.getDuration(startedAt, run.UpdatedAt, run.Status)
+	if run.Status == "completed" {
+		detail.FinishedAt = u.timeToString(run.UpdatedAt)
+	}
+
+	for _, pullRequest := range run.PullRequests {
+		detail.PullRequests = append(detail.PullRequests, RunPullRequest{
+			Number:  pullRequest.Number,
+			URL:     fmt.Sprintf("https://github.com/%s/pull/%d", repository, pullRequest.Number),
+			HeadRef: pullRequest.Head.Ref,
+			BaseRef: pullRequest.Base.Ref,
+		})
+	}
+
+	return detail
+}
+
+// runJobList returns the jobs of a run with their states
+func runJobList(runJobs []gr.WorkflowJob) []RunJob {
+	var jobs []RunJob
+	for _, runJob := range runJobs {
+		state := runJob.Conclusion
+		if state == "" {
+			state = runJob.Status
+		}
+		jobs = append(jobs, RunJob{ID: runJob.ID, Name: runJob.Name, State: state})
+	}
+	return jobs
+}
+
+// jobStatePriority orders the states of jobs, the first state wins when a job runs more than once like a matrix
+var jobStatePriority = []string{"failure", "timed_out", "cancelled", "action_required", "startup_failure",
+	"in_progress", "queued", "waiting", "requested", "pending", "success", "neutral", "skipped"}
+
+// jobStates matches the jobs of a run to the jobs of the workflow file by their names.
+// GitHub names a job of a run after the name of the job, or its id if it has no name, followed by the values of
+// its matrix like "build (ubuntu-latest, 1.24)" or the name of the called job like "deploy / upload".
+func jobStates(jobs []py.WorkflowJob, runJobs []gr.WorkflowJob) map[string]string {
+	var states = make(map[string]string)
+
+	for _, job := range jobs {
+		name := job.ID
+		if job.Name != "" {
+			name = job.Name
+		}
+
+		// A name with an expression is matched by the part before the expression
+		var hasExpression bool
+		if before, _, found := strings.Cut(name, "${{"); found {
+			name, hasExpression = strings.TrimSpace(before), true
+			if name == "" {
+				continue
+			}
+		}
+
+		for _, runJob := range runJobs {
+			matches := runJob.Name == name ||
+				strings.HasPrefix(runJob.Name, name+" (") ||
+				strings.HasPrefix(runJob.Name, name+" / ") ||
+				(hasExpression && strings.HasPrefix(runJob.Name, name))
+			if !matches {
+				continue
+			}
+
+			state := runJob.Status
+			if state == "completed" {
+				state = runJob.Conclusion
+			}
+
+			current, ok := states[job.ID]
+			if !ok || priority(state) < priority(current) {
+				states[job.ID] = state
+			}
+		}
+	}
+
+	return states
+}
+
+func priority(state string) int {
+	if index := slices.Index(jobStatePriority, state); index >= 0 {
+		return index
+	}
+	return len(jobStatePriority)
+}
+
 func (u useCase) TriggerWorkflow(ctx context.Context, input TriggerWorkflowInput) error {
 	return u.githubRepository.TriggerWorkflow(ctx, input.Repository, input.Branch, input.WorkflowFile, input.Content)
 }
 
+func (u useCase) GetPendingDeployments(ctx context.Context, input GetPendingDeploymentsInput) (*GetPendingDeploymentsOutput, error) {
+	pendingDeployments, err := u.githubRepository.ListPendingDeployments(ctx, input.Repository, input.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	var output GetPendingDeploymentsOutput
+	for _, pendingDeployment := range pendingDeployments {
+		output.Deployments = append(output.Deployments, u.pendingDeployment(pendingDeployment))
+	}
+	return &output, nil
+}
+
+func (u useCase) pendingDeployment(pendingDeployment gr.PendingDeployment) PendingDeployment {
+	deployment := PendingDeployment{
+		EnvironmentID: pendingDeployment.Environment.ID,
+		Environment:   pendingDeployment.Environment.Name,
+		CanApprove:    pendingDeployment.CurrentUserCanApprove,
+		WaitTimer:     pendingDeployment.WaitTimer,
+	}
+	if pendingDeployment.WaitTimer > 0 && !pendingDeployment.WaitTimerStartedAt.IsZero() {
+		deployment.WaitUntil = u.timeToString(pendingDeployment.WaitTimerStartedAt.Add(
+			time.Duration(pendingDeployment.WaitTimer) * time.Minute))
+	}
+
+	for _, reviewer := range pendingDeployment.Reviewers {
+		switch {
+		case reviewer.Reviewer.Login != "":
+			deployment.Reviewers = append(deployment.Reviewers, reviewer.Reviewer.Login)
+		case reviewer.Reviewer.Slug != "":
+			deployment.Reviewers = append(deployment.Reviewers, reviewer.Reviewer.Slug)
+		case reviewer.Reviewer.Name != "":
+			deployment.Reviewers = append(deployment.Reviewers, reviewer.Reviewer.Name)
+		}
+	}
+	return deployment
+}
+
+func (u useCase) ReviewPendingDeployments(ctx context.Context, input ReviewPendingDeploymentsInput) (*ReviewPendingDeploymentsOutput, error) {
+	input.Prepare()
+
+	pendingDeployments, err := u.githubRepository.ListPendingDeployments(ctx, input.Repository, input.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	environmentIDs, environments, err := reviewableEnvironments(pendingDeployments)
+	if err != nil {
+		return nil, err
+	}
+
+	state := "rejected"
+	if input.Approve {
+		state = "approved"
+	}
+	if err := u.githubRepository.ReviewPendingDeployments(ctx, input.Repository, input.RunID, environmentIDs, state, input.Comment); err != nil {
+		return nil, err
+	}
+
+	return &ReviewPendingDeploymentsOutput{
+		Environments: environments,
+	}, nil
+}
+
+// reviewableEnvironments returns the environments of the pending deployments the authenticated user can review
+func reviewableEnvironments(pendingDeployments []gr.PendingDeployment) ([]int64, []string, error) {
+	if len(pendingDeployments) == 0 {
+		return nil, nil, errors.New("the run has no deployments waiting for a review")
+	}
+
+	var environmentIDs []int64
+	var environments []string
+	for _, pendingDeployment := range pendingDeployments {
+		if pendingDeployment.CurrentUserCanApprove {
+			environmentIDs = append(environmentIDs, pendingDeployment.Environment.ID)
+			environments = append(environments, pendingDeployment.Environment.Name)
+		}
+	}
+	if len(environmentIDs) == 0 {
+		return nil, nil, errors.New("you are not a reviewer of the environments the run waits for")
+	}
+	return environmentIDs, environments, nil
+}
+
 func (u useCase) ReRunFailedJobs(ctx context.Context, input ReRunFailedJobsInput) error {
-	return u.githubRepository.ReRunFailedJobs(ctx, input.Repository, input.WorkflowID)
+	return u.githubRepository.ReRunFailedJobs(ctx, input.Repository, input.WorkflowID, input.EnableDebugLogging)
 }
 
 func (u useCase) ReRunWorkflow(ctx context.Context, input ReRunWorkflowInput) error {
-	return u.githubRepository.ReRunWorkflow(ctx, input.Repository, input.WorkflowID)
+	return u.githubRepository.ReRunWorkflow(ctx, input.Repository, input.WorkflowID, input.EnableDebugLogging)
+}
+
+func (u useCase) ReRunJob(ctx context.Context, input ReRunJobInput) error {
+	return u.githubRepository.ReRunJob(ctx, input.Repository, input.JobID, input.EnableDebugLogging)
 }
 
 func (u useCase) CancelWorkflow(ctx context.Context, input CancelWorkflowInput) error {
+	if input.Force {
+		return u.githubRepository.ForceCancelWorkflow(ctx, input.Repository, input.WorkflowID)
+	}
 	return u.githubRepository.CancelWorkflow(ctx, input.Repository, input.WorkflowID)
 }
 
+func (u useCase) DeleteWorkflowRun(ctx context.Context, input DeleteWorkflowRunInput) error {
+	return u.githubRepository.DeleteWorkflowRun(ctx, input.Repository, input.RunID)
+}
+
+func (u useCase) DeleteWorkflowRunLogs(ctx context.Context, input DeleteWorkflowRunLogsInput) error {
+	return u.githubRepository.DeleteWorkflowRunLogs(ctx, input.Repository, input.RunID)
+}
+
+func (u useCase) EnableWorkflow(ctx context.Context, input EnableWorkflowInput) error {
+	return u.githubRepository.EnableWorkflow(ctx, input.Repository, input.WorkflowID)
+}
+
+func (u useCase) DisableWorkflow(ctx context.Context, input DisableWorkflowInput) error {
+	return u.githubRepository.DisableWorkflow(ctx, input.Repository, input.WorkflowID)
+}
+
+func (u useCase) GetRepositoryDispatchEvents(ctx context.Context, input GetRepositoryDispatchEventsInput) (*GetRepositoryDispatchEventsOutput, error) {
+	workflows, err := u.githubRepository.GetWorkflowsWithTriggers(ctx, input.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	var output GetRepositoryDispatchEventsOutput
+	var eventTypes = make(map[string][]string)
+	for _, workflow := range workflows {
+		if !workflow.Triggers.Has("repository_dispatch") {
+			continue
+		}
+
+		if len(workflow.Triggers.RepositoryDispatchTypes) == 0 {
+			output.CatchAllWorkflows = append(output.CatchAllWorkflows, workflow.Name)
+			continue
+		}
+
+		for _, eventType := range workflow.Triggers.RepositoryDispatchTypes {
+			eventTypes[eventType] = append(eventTypes[eventType], workflow.Name)
+		}
+	}
+
+	for name, listeners := range eventTypes {
+		slices.Sort(listeners)
+		output.EventTypes = append(output.EventTypes, RepositoryDispatchEventType{
+			Name:      name,
+			Workflows: listeners,
+		})
+	}
+
+	slices.SortFunc(output.EventTypes, func(a, b RepositoryDispatchEventType) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	slices.Sort(output.CatchAllWorkflows)
+
+	return &output, nil
+}
+
+func (u useCase) CreateRepositoryDispatch(ctx context.Context, input CreateRepositoryDispatchInput) error {
+	eventType := strings.TrimSpace(input.EventType)
+	if eventType == "" {
+		return errors.New("event type cannot be empty")
+	}
+	if len(eventType) > maxEventTypeLength {
+		return fmt.Errorf("event type cannot be longer than %d characters", maxEventTypeLength)
+	}
+
+	if err := ValidateClientPayload(input.ClientPayload); err != nil {
+		return err
+	}
+
+	var clientPayload json.RawMessage
+	if payload := strings.TrimSpace(input.ClientPayload); payload != "" {
+		clientPayload = json.RawMessage(payload)
+	}
+
+	return u.githubRepository.CreateRepositoryDispatch(ctx, input.Repository, eventType, clientPayload)
+}
+
+// ValidateClientPayload checks the client payload of a repository_dispatch event, an empty payload is valid
+func ValidateClientPayload(payload string) error {
+	payload = strings.TrimSpace(payload)
+	if payload == "" {
+		return nil
+	}
+
+	node, err := py.ParseJSON(payload)
+	if err != nil {
+		return fmt.Errorf("client payload is not valid JSON: %w", err)
+	}
+	if node.Kind != py.JSONObject {
+		return errors.New("client payload must be a JSON object")
+	}
+	if len(node.Children) > maxClientPayloadProperties {
+		return fmt.Errorf("client payload cannot have more than %d top-level properties", maxClientPayloadProperties)
+	}
+	return nil
+}
+
+// GitHub rejects repository_dispatch events beyond these limits
+const (
+	maxEventTypeLength         = 100
+	maxClientPayloadProperties = 10
+)
+
 func (u useCase) timeToString(t time.Time) string {
 	return t.In(time.Local).Format("2006-01-02 15:04:05")
 }
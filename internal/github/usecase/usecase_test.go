@@ -2,12 +2,16 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/termkit/gama/internal/config"
 
 	"github.com/termkit/gama/internal/github/domain"
 	"github.com/termkit/gama/internal/github/repository"
+	py "github.com/termkit/gama/pkg/yaml"
 )
 
 func TestUseCase_ListRepositories(t *testing.T) {
@@ -95,3 +99,537 @@ func TestUseCase_TriggerWorkflow(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestUseCase_CreateRepositoryDispatch_Validation(t *testing.T) {
+	// Invalid inputs are rejected before any request is sent
+	githubUseCase := New(nil)
+
+	tests := []struct {
+		name  string
+		input CreateRepositoryDispatchInput
+	}{
+		{
+			name:  "empty event type",
+			input: CreateRepositoryDispatchInput{Repository: "canack/tc", EventType: " "},
+		},
+		{
+			name:  "long event type",
+			input: CreateRepositoryDispatchInput{Repository: "canack/tc", EventType: strings.Repeat("a", 101)},
+		},
+		{
+			name:  "invalid payload",
+			input: CreateRepositoryDispatchInput{Repository: "canack/tc", EventType: "deploy", ClientPayload: `{"env":`},
+		},
+		{
+			name:  "array payload",
+			input: CreateRepositoryDispatchInput{Repository: "canack/tc", EventType: "deploy", ClientPayload: `["prod"]`},
+		},
+		{
+			name: "too many properties",
+			input: CreateRepositoryDispatchInput{Repository: "canack/tc", EventType: "deploy",
+				ClientPayload: `{"a":1,"b":2,"c":3,"d":4,"e":5,"f":6,"g":7,"h":8,"i":9,"j":10,"k":11}`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := githubUseCase.CreateRepositoryDispatch(context.Background(), tt.input); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestValidateClientPayload(t *testing.T) {
+	valid := []string{"", " ", `{"env": "prod"}`, `{"a":1,"b":2,"c":3,"d":4,"e":5,"f":6,"g":7,"h":8,"i":9,"j":10}`}
+	for _, payload := range valid {
+		if err := ValidateClientPayload(payload); err != nil {
+			t.Errorf("ValidateClientPayload(%q) = %v, want nil", payload, err)
+		}
+	}
+
+	invalid := []string{`{"env":`, `["prod"]`, `{"a":1,"b":2,"c":3,"d":4,"e":5,"f":6,"g":7,"h":8,"i":9,"j":10,"k":11}`}
+	for _, payload := range invalid {
+		if err := ValidateClientPayload(payload); err == nil {
+			t.Errorf("ValidateClientPayload(%q) = nil, want an error", payload)
+		}
+	}
+}
+
+func TestJobStates(t *testing.T) {
+	jobs := []py.WorkflowJob{
+		{ID: "lint"},
+		{ID: "test", Name: "Test"},
+		{ID: "deploy", Name: "Deploy to ${{ inputs.environment }}"},
+		{ID: "release"},
+	}
+	runJobs := []repository.WorkflowJob{
+		{Name: "lint", Status: "completed", Conclusion: "success"},
+		{Name: "Test (ubuntu-latest)", Status: "completed", Conclusion: "success"},
+		{Name: "Test (windows-latest)", Status: "completed", Conclusion: "failure"},
+		{Name: "Test (macos-latest)", Status: "in_progress"},
+		{Name: "Deploy to staging", Status: "queued"},
+	}
+
+	got := jobStates(jobs, runJobs)
+	want := map[string]string{
+		"lint":   "success",
+		"test":   "failure",
+		"deploy": "queued",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("jobStates() = %v, want %v", got, want)
+	}
+	for job, state := range want {
+		if got[job] != state {
+			t.Errorf("jobStates()[%q] = %q, want %q", job, got[job], state)
+		}
+	}
+}
+
+func TestParseRunFilter(t *testing.T) {
+	filter := ParseRunFilter(`Status:failure status:cancelled actor:alice workflow:"Deploy prod" fix  label:x`)
+
+	if got, want := filter.String(), `status:failure status:cancelled actor:alice workflow:"Deploy prod" fix label:x`; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	// Status has two values, GitHub can only filter by one of them
+	got := filter.apiFilter()
+	if want := (repository.WorkflowRunsFilter{Actor: "alice"}); got != want {
+		t.Errorf("apiFilter() = %+v, want %+v", got, want)
+	}
+
+	got = ParseRunFilter("status:failing branch:main event:schedule").apiFilter()
+	if want := (repository.WorkflowRunsFilter{Branch: "main", Event: "schedule"}); got != want {
+		t.Errorf("apiFilter() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRunFilter_Match(t *testing.T) {
+	run := Workflow{
+		WorkflowName: "Deploy",
+		ActionName:   "Fix login redirect",
+		TriggeredBy:  "alice",
+		Status:       "completed",
+		Conclusion:   "failure",
+		Branch:       "main",
+		Event:        "push",
+		Path:         ".github/workflows/deploy.yaml",
+	}
+
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"", true},
+		{"login", true},
+		{"LOGIN fix", true},
+		{"login signup", false},
+		{"status:failure", true},
+		{"status:completed", true},
+		{"status:success status:failure", true},
+		{"status:success", false},
+		{"actor:Alice", true},
+		{"actor:ali", false},
+		{"workflow:deploy.yaml branch:main", true},
+		{"workflow:release", false},
+		{"event:push status:failure alice", true},
+		{"event:schedule", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if got := ParseRunFilter(tt.query).Match(run); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUseCase_RunDetail(t *testing.T) {
+	started := time.Date(2025, 1, 2, 10, 0, 30, 0, time.UTC)
+	run := repository.WorkflowRun{
+		ID:              42,
+		Name:            "CI",
+		Status:          "completed",
+		Conclusion:      "success",
+		Event:           "pull_request",
+		CreatedAt:       time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC),
+		RunStartedAt:    started,
+		UpdatedAt:       started.Add(90 * time.Second),
+		HeadSHA:         "0123456789abcdef",
+		RunAttempt:      2,
+		Actor:           repository.Actor{Login: "alice"},
+		TriggeringActor: repository.Actor{Login: "bob"},
+	}
+	run.HeadCommit.Message = "Fix the build\n\nLong description"
+	run.PullRequests = []repository.PullRequest{{Number: 7}}
+	run.PullRequests[0].Head.Ref = "fix"
+	run.PullRequests[0].Base.Ref = "main"
+
+	detail := useCase{}.runDetail("termkit/gama", run, 3)
+
+	if detail.CommitMessage != "Fix the build" {
+		t.Errorf("CommitMessage = %q, want the first line of the message", detail.CommitMessage)
+	}
+	if detail.Attempt != 2 || detail.Attempts != 3 {
+		t.Errorf("Attempt = %d of %d, want 2 of 3", detail.Attempt, detail.Attempts)
+	}
+	if detail.Actor != "alice" || detail.TriggeringActor != "bob" {
+		t.Errorf("Actor = %q, TriggeringActor = %q", detail.Actor, detail.TriggeringActor)
+	}
+	if detail.Duration != "1m 30s" || detail.FinishedAt == "" {
+		t.Errorf("Duration = %q, FinishedAt = %q", detail.Duration, detail.FinishedAt)
+	}
+	if len(detail.PullRequests) != 1 || detail.PullRequests[0].URL != "https://github.com/termkit/gama/pull/7" ||
+		detail.PullRequests[0].BaseRef != "main" {
+		t.Errorf("PullRequests = %+v", detail.PullRequests)
+	}
+
+	run.Status = "in_progress"
+	if detail := (useCase{}).runDetail("termkit/gama", run, 2); detail.FinishedAt != "" {
+		t.Errorf("FinishedAt = %q, want it empty while the run is not completed", detail.FinishedAt)
+	}
+}
+
+func TestUniqueRepositories(t *testing.T) {
+	got := uniqueRepositories([]string{"termkit/gama", " termkit/skeleton ", "", "Termkit/Gama"})
+	want := []string{"termkit/gama", "termkit/skeleton"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("uniqueRepositories() = %v, want %v", got, want)
+	}
+}
+
+func TestMergeWorkflowHistories(t *testing.T) {
+	histories := [][]Workflow{
+		{
+			{ID: 1, Repository: "b/api", QueuedAt: "2025-01-02 10:00:00"},
+			{ID: 2, Repository: "b/api", QueuedAt: "2025-01-01 09:00:00"},
+		},
+		{
+			{ID: 3, Repository: "a/web", QueuedAt: "2025-01-03 08:00:00"},
+			{ID: 4, Repository: "a/web", QueuedAt: "2025-01-01 09:00:00"},
+		},
+	}
+
+	var got []int64
+	for _, workflow := range mergeWorkflowHistories(histories) {
+		got = append(got, workflow.ID)
+	}
+	want := []int64{3, 1, 4, 2}
+	if len(got) != len(want) {
+		t.Fatalf("mergeWorkflowHistories() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("mergeWorkflowHistories() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestWorkflowStatistics(t *testing.T) {
+	now := time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)
+	run := func(daysAgo int, conclusion string, attempt int, queue, duration time.Duration) repository.WorkflowRun {
+		createdAt := now.AddDate(0, 0, -daysAgo)
+		return repository.WorkflowRun{
+			Status:       "completed",
+			Conclusion:   conclusion,
+			RunAttempt:   attempt,
+			CreatedAt:    createdAt,
+			RunStartedAt: createdAt.Add(queue),
+			UpdatedAt:    createdAt.Add(queue + duration),
+		}
+	}
+
+	runs := []repository.WorkflowRun{
+		run(1, "failure", 1, time.Second, 2*time.Minute),
+		run(2, "failure", 1, time.Second, 3*time.Minute),
+		run(3, "success", 2, time.Minute, 4*time.Minute),
+		run(4, "failure", 1, 2*time.Second, 5*time.Minute),
+		run(5, "failure", 1, 3*time.Second, 6*time.Minute),
+		run(6, "failure", 1, 4*time.Second, 7*time.Minute),
+		run(6, "cancelled", 1, time.Second, time.Minute),
+		run(20, "success", 1, 5*time.Second, 10*time.Minute),
+		{Status: "in_progress", CreatedAt: now.Add(-time.Hour)},
+	}
+
+	statistics := workflowStatistics(runs, 7, now)
+	if statistics.Runs != 8 || statistics.Succeeded != 1 || statistics.Failed != 5 || statistics.Cancelled != 1 {
+		t.Fatalf("counts = %d runs, %d succeeded, %d failed, %d cancelled", statistics.Runs, statistics.Succeeded,
+			statistics.Failed, statistics.Cancelled)
+	}
+	if statistics.Flaky != 1 {
+		t.Errorf("Flaky = %d, want 1", statistics.Flaky)
+	}
+	if statistics.SuccessRate != 1.0/6 {
+		t.Errorf("SuccessRate = %v, want %v", statistics.SuccessRate, 1.0/6)
+	}
+	if statistics.LongestFailureStreak != 3 || statistics.CurrentFailureStreak != 2 {
+		t.Errorf("failure streaks = %d longest, %d current, want 3 and 2", statistics.LongestFailureStreak,
+			statistics.CurrentFailureStreak)
+	}
+	// The duration of the cancelled run isn't counted
+	if len(statistics.Durations) != 6 || statistics.DurationP50 != 4*time.Minute || statistics.DurationP90 != 7*time.Minute {
+		t.Errorf("durations = %v, %v p50, %v p90", statistics.Durations, statistics.DurationP50, statistics.DurationP90)
+	}
+	// The queue time of the re-run isn't counted
+	if len(statistics.QueueTimes) != 6 || statistics.QueueP90 != 4*time.Second {
+		t.Errorf("queue times = %v, p90 %v", statistics.QueueTimes, statistics.QueueP90)
+	}
+	if len(statistics.DailySuccessRates) != 7 || statistics.DailySuccessRates[0] != -1 || statistics.DailySuccessRates[4] != 1 {
+		t.Errorf("DailySuccessRates = %v", statistics.DailySuccessRates)
+	}
+
+	if statistics := workflowStatistics(runs, 30, now); statistics.Runs != 9 || statistics.Succeeded != 2 {
+		t.Errorf("30 days = %d runs, %d succeeded, want 9 and 2", statistics.Runs, statistics.Succeeded)
+	}
+}
+
+func TestIsWindowTruncated(t *testing.T) {
+	now := time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)
+	runs := []repository.WorkflowRun{
+		{CreatedAt: now.AddDate(0, 0, -1)},
+		{CreatedAt: now.AddDate(0, 0, -10)},
+	}
+
+	if isWindowTruncated(runs, 2, now.AddDate(0, 0, -30)) {
+		t.Error("all the runs are listed, the window isn't truncated")
+	}
+	if isWindowTruncated(runs, 5, now.AddDate(0, 0, -7)) {
+		t.Error("the listed runs reach past the start of the window, the window isn't truncated")
+	}
+	if !isWindowTruncated(runs, 5, now.AddDate(0, 0, -30)) {
+		t.Error("runs of the window aren't listed, the window is truncated")
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	durations := []time.Duration{5, 1, 4, 2, 3}
+	for p, want := range map[float64]time.Duration{0: 1, 0.5: 3, 0.9: 5, 1: 5} {
+		if got := percentile(durations, p); got != want {
+			t.Errorf("percentile(%v) = %v, want %v", p, got, want)
+		}
+	}
+	if got := percentile(nil, 0.5); got != 0 {
+		t.Errorf("percentile of no durations = %v, want 0", got)
+	}
+}
+
+func TestBillableTimes(t *testing.T) {
+	times := billableTimes(map[string]repository.RunBillable{
+		"MACOS":   {TotalMS: 61_000, Jobs: 1},
+		"UBUNTU":  {TotalMS: 120_000, Jobs: 3},
+		"WINDOWS": {},
+	})
+
+	if len(times) != 2 {
+		t.Fatalf("billableTimes() = %v, want Ubuntu and macOS", times)
+	}
+	if times[0].OS != "Ubuntu" || times[0].Minutes != 2 || times[0].Jobs != 3 {
+		t.Errorf("Ubuntu = %+v, want 2 minutes of 3 jobs", times[0])
+	}
+	// 61 seconds are rounded up to 2 minutes, macOS minutes count 10 times
+	if times[1].OS != "macOS" || times[1].Minutes != 20 || times[1].Multiplier != 10 {
+		t.Errorf("macOS = %+v, want 20 minutes", times[1])
+	}
+}
+
+func TestWorkflowUsage(t *testing.T) {
+	usages := []WorkflowUsage{
+		workflowUsage(repository.Workflow{ID: 1, Name: "lint"}, map[string]repository.WorkflowBillable{
+			"UBUNTU": {TotalMS: 30 * 60_000},
+		}),
+		workflowUsage(repository.Workflow{ID: 2, Name: "release"}, map[string]repository.WorkflowBillable{
+			"UBUNTU":  {TotalMS: 5 * 60_000},
+			"WINDOWS": {TotalMS: 90_000},
+			"MACOS":   {TotalMS: 3 * 60_000},
+		}),
+		workflowUsage(repository.Workflow{ID: 3, Name: "Docs"}, nil),
+		workflowUsage(repository.Workflow{ID: 4, Name: "build"}, map[string]repository.WorkflowBillable{
+			"UBUNTU": {TotalMS: 30 * 60_000},
+		}),
+	}
+
+	if usages[1].Minutes != 5+2*2+3*10 {
+		t.Errorf("release minutes = %d, want %d", usages[1].Minutes, 5+2*2+3*10)
+	}
+
+	sortWorkflowUsages(usages)
+	var got []int64
+	for _, usage := range usages {
+		got = append(got, usage.ID)
+	}
+	want := []int64{2, 4, 1, 3}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("sortWorkflowUsages() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestReviewableEnvironments(t *testing.T) {
+	if _, _, err := reviewableEnvironments(nil); err == nil {
+		t.Error("reviewableEnvironments() of no deployments should fail")
+	}
+
+	deployment := func(id int64, name string, canApprove bool) repository.PendingDeployment {
+		return repository.PendingDeployment{
+			Environment:           repository.GithubEnvironment{ID: id, Name: name},
+			CurrentUserCanApprove: canApprove,
+		}
+	}
+
+	if _, _, err := reviewableEnvironments([]repository.PendingDeployment{deployment(1, "staging", false)}); err == nil {
+		t.Error("reviewableEnvironments() without an approvable deployment should fail")
+	}
+
+	ids, names, err := reviewableEnvironments([]repository.PendingDeployment{
+		deployment(1, "staging", true),
+		deployment(2, "production", false),
+		deployment(3, "production-eu", true),
+	})
+	if err != nil {
+		t.Fatalf("reviewableEnvironments() error = %v", err)
+	}
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 3 || strings.Join(names, ",") != "staging,production-eu" {
+		t.Errorf("reviewableEnvironments() = %v %v, want staging and production-eu", ids, names)
+	}
+}
+
+func TestReviewPendingDeploymentsInput_Prepare(t *testing.T) {
+	input := ReviewPendingDeploymentsInput{Approve: true, Comment: "  "}
+	input.Prepare()
+	if input.Comment != "Approved from gama" {
+		t.Errorf("Prepare() comment = %q, want the default approval comment", input.Comment)
+	}
+
+	input = ReviewPendingDeploymentsInput{Comment: " broken migration "}
+	input.Prepare()
+	if input.Comment != "broken migration" {
+		t.Errorf("Prepare() comment = %q, want %q", input.Comment, "broken migration")
+	}
+}
+
+func TestRunJobList(t *testing.T) {
+	jobs := runJobList([]repository.WorkflowJob{
+		{ID: 1, Name: "lint", Status: "completed", Conclusion: "success"},
+		{ID: 2, Name: "Test (ubuntu-latest)", Status: "in_progress"},
+	})
+
+	if len(jobs) != 2 {
+		t.Fatalf("runJobList() = %v, want 2 jobs", jobs)
+	}
+	if jobs[0] != (RunJob{ID: 1, Name: "lint", State: "success"}) {
+		t.Errorf("runJobList()[0] = %+v, want the conclusion of the completed job", jobs[0])
+	}
+	if jobs[1] != (RunJob{ID: 2, Name: "Test (ubuntu-latest)", State: "in_progress"}) {
+		t.Errorf("runJobList()[1] = %+v, want the status of the running job", jobs[1])
+	}
+}
+
+func TestBulkRunSelection(t *testing.T) {
+	runs := []Workflow{
+		{ID: 1, Status: "queued"},
+		{ID: 2, Status: "in_progress"},
+		{ID: 3, Status: "completed", Conclusion: "failure"},
+		{ID: 4, Status: "completed", Conclusion: "success"},
+		{ID: 5, Status: "completed", Conclusion: "timed_out"},
+		{ID: 6, Status: "waiting"},
+	}
+
+	if got := activeRuns(runs); len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 6 {
+		t.Errorf("activeRuns() = %v, want [1 2 6]", got)
+	}
+	if got := failedRuns(runs); len(got) != 2 || got[0] != 3 || got[1] != 5 {
+		t.Errorf("failedRuns() = %v, want [3 5]", got)
+	}
+}
+
+func TestForEachRun(t *testing.T) {
+	errConflict := errors.New("conflict")
+	output := forEachRun(context.Background(), []int64{5, 3, 8, 1, 9}, func(_ context.Context, runID int64) error {
+		if runID%3 == 0 {
+			return errConflict
+		}
+		return nil
+	})
+
+	if len(output.Runs) != 3 || output.Runs[0] != 1 || output.Runs[1] != 5 || output.Runs[2] != 8 {
+		t.Errorf("forEachRun() runs = %v, want [1 5 8]", output.Runs)
+	}
+	if len(output.Failures) != 2 || output.Failures[0].RunID != 3 || output.Failures[1].RunID != 9 ||
+		!errors.Is(output.Failures[0].Err, errConflict) {
+		t.Errorf("forEachRun() failures = %v, want runs 3 and 9", output.Failures)
+	}
+}
+
+func TestFindWorkflow(t *testing.T) {
+	workflows := []repository.Workflow{
+		{ID: 11, Name: "CI", Path: ".github/workflows/ci.yaml"},
+		{ID: 12, Name: "Release", Path: ".github/workflows/release.yaml"},
+		{ID: 13, Name: "Release", Path: ".github/workflows/release-docs.yaml"},
+	}
+
+	for _, workflow := range []string{"11", "ci.yaml", ".github/workflows/ci.yaml", "ci"} {
+		found, err := findWorkflow(workflows, workflow)
+		if err != nil || found.ID != 11 {
+			t.Errorf("findWorkflow(%q) = %d, %v, want the CI workflow", workflow, found.ID, err)
+		}
+	}
+
+	if _, err := findWorkflow(workflows, "Release"); err == nil {
+		t.Error("findWorkflow() of a name of two workflows didn't fail")
+	}
+	if _, err := findWorkflow(workflows, "deploy.yaml"); err == nil {
+		t.Error("findWorkflow() of a missing workflow didn't fail")
+	}
+}
+
+func TestIsWorkflowIDOrFile(t *testing.T) {
+	for _, workflow := range []string{"11", "ci.yaml", "release.yml", ".github/workflows/ci.yaml"} {
+		if !isWorkflowIDOrFile(workflow) {
+			t.Errorf("isWorkflowIDOrFile(%q) = false, want true", workflow)
+		}
+	}
+	for _, workflow := range []string{"CI", "Release docs", "ci.yaml.bak"} {
+		if isWorkflowIDOrFile(workflow) {
+			t.Errorf("isWorkflowIDOrFile(%q) = true, want false", workflow)
+		}
+	}
+}
+
+func TestOldRuns(t *testing.T) {
+	cutoff := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	runs := []repository.WorkflowRun{
+		{ID: 1, RunNumber: 7, Status: "completed", CreatedAt: cutoff.Add(-time.Hour)},
+		{ID: 2, RunNumber: 5, Status: "completed", CreatedAt: cutoff.AddDate(0, 0, -3)},
+		{ID: 3, RunNumber: 6, Status: "in_progress", CreatedAt: cutoff.AddDate(0, 0, -2)},
+		{ID: 4, RunNumber: 8, Status: "completed", CreatedAt: cutoff.Add(time.Hour)},
+	}
+
+	old, skipped := oldRuns(runs, cutoff)
+	if len(old) != 2 || old[0].ID != 2 || old[1].ID != 1 {
+		t.Errorf("oldRuns() = %+v, want runs 2 and 1, the oldest first", old)
+	}
+	if len(skipped) != 1 || skipped[0] != 3 {
+		t.Errorf("oldRuns() skipped = %v, want the run in progress", skipped)
+	}
+}
+
+func TestUnlistedRuns(t *testing.T) {
+	tests := []struct {
+		totalCount int64
+		listed     int
+		want       int
+	}{
+		{totalCount: 250, listed: 250, want: 0},
+		{totalCount: 2500, listed: 1000, want: 1500},
+		// Runs created while the pages are listed can make the count lag behind
+		{totalCount: 990, listed: 1000, want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := unlistedRuns(tt.totalCount, tt.listed); got != tt.want {
+			t.Errorf("unlistedRuns(%d, %d) = %d, want %d", tt.totalCount, tt.listed, got, tt.want)
+		}
+	}
+}
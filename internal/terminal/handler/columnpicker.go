@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ModelColumnPicker lets the columns of a table be shown, hidden and reordered
+type ModelColumnPicker struct {
+	help help.Model
+	keys columnPickerKeyMap
+
+	visible bool
+	title   string
+	cursor  int
+	choices []columnChoice
+}
+
+// columnChoice is a column of the picker, shown columns are listed first in the order the table shows them
+type columnChoice struct {
+	key   string
+	title string
+	shown bool
+}
+
+// columnPickerResult is the result of a key press in the picker
+type columnPickerResult int
+
+const (
+	columnPickerOpen columnPickerResult = iota
+	columnPickerSaved
+	columnPickerCancelled
+)
+
+func SetupModelColumnPicker() *ModelColumnPicker {
+	return &ModelColumnPicker{
+		help: help.New(),
+		keys: columnPickerKeys,
+	}
+}
+
+func (m *ModelColumnPicker) Open(title string, choices []columnChoice) {
+	m.title = title
+	m.choices = choices
+	m.cursor = 0
+	m.visible = true
+}
+
+func (m *ModelColumnPicker) Close() {
+	m.visible = false
+}
+
+func (m *ModelColumnPicker) Visible() bool {
+	return m.visible
+}
+
+// Keys returns the keys of the shown columns in their order
+func (m *ModelColumnPicker) Keys() []string {
+	var keys []string
+	for _, choice := range m.choices {
+		if choice.shown {
+			keys = append(keys, choice.key)
+		}
+	}
+	return keys
+}
+
+// Update handles a key press, the picker is closed when the columns are saved or the picker is cancelled
+func (m *ModelColumnPicker) Update(msg tea.KeyMsg) columnPickerResult {
+	switch {
+	case key.Matches(msg, m.keys.Up):
+		m.cursor = max(m.cursor-1, 0)
+	case key.Matches(msg, m.keys.Down):
+		m.cursor = min(m.cursor+1, len(m.choices)-1)
+	case key.Matches(msg, m.keys.MoveUp):
+		if m.cursor > 0 {
+			m.choices[m.cursor], m.choices[m.cursor-1] = m.choices[m.cursor-1], m.choices[m.cursor]
+			m.cursor--
+		}
+	case key.Matches(msg, m.keys.MoveDown):
+		if m.cursor < len(m.choices)-1 {
+			m.choices[m.cursor], m.choices[m.cursor+1] = m.choices[m.cursor+1], m.choices[m.cursor]
+			m.cursor++
+		}
+	case key.Matches(msg, m.keys.Toggle):
+		if len(m.choices) > 0 {
+			m.choices[m.cursor].shown = !m.choices[m.cursor].shown
+		}
+	case key.Matches(msg, m.keys.Save):
+		if len(m.Keys()) == 0 {
+			// A table needs a column, keep the picker open
+			return columnPickerOpen
+		}
+		m.visible = false
+		return columnPickerSaved
+	case key.Matches(msg, m.keys.Cancel):
+		m.visible = false
+		return columnPickerCancelled
+	}
+	return columnPickerOpen
+}
+
+func (m *ModelColumnPicker) View(width int) string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("39")).
+		Padding(0, 1).
+		Width(width).
+		MarginLeft(1)
+
+	titleStyle := lipgloss.NewStyle().Bold(true)
+	selectedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("229")).Background(lipgloss.Color("57"))
+	hiddenStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+	lines := []string{titleStyle.Render(m.title), ""}
+	for i, choice := range m.choices {
+		mark := "[ ]"
+		if choice.shown {
+			mark = "[x]"
+		}
+		line := fmt.Sprintf("%s %s", mark, choice.title)
+		switch {
+		case i == m.cursor:
+			line = selectedStyle.Render(line)
+		case !choice.shown:
+			line = hiddenStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	return style.Render(strings.Join(lines, "\n"))
+}
+
+func (m *ModelColumnPicker) ViewHelp() string {
+	return m.help.View(m.keys)
+}
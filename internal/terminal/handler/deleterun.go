@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gu "github.com/termkit/gama/internal/github/usecase"
+	"github.com/termkit/skeleton"
+)
+
+// deleteRunTarget is what the typed confirmation of a run deletes
+type deleteRunTarget int
+
+const (
+	deleteNothing deleteRunTarget = iota
+	deleteRun                     // the run and its logs
+	deleteRunLogs                 // only the logs of the run
+)
+
+// ModelDeleteRun asks to type the run number before a run is deleted, or logs before its logs are deleted
+type ModelDeleteRun struct {
+	skeleton *skeleton.Skeleton
+
+	visible           bool
+	run               gu.Workflow
+	confirmationInput textinput.Model
+}
+
+func SetupModelDeleteRun(sk *skeleton.Skeleton) *ModelDeleteRun {
+	ti := textinput.New()
+	ti.Blur()
+	ti.CharLimit = 32
+	ti.ShowSuggestions = false
+
+	return &ModelDeleteRun{
+		skeleton:          sk,
+		confirmationInput: ti,
+	}
+}
+
+// Open asks for the confirmation of the deletion of the run
+func (m *ModelDeleteRun) Open(run gu.Workflow) tea.Cmd {
+	m.run = run
+	m.visible = true
+	m.confirmationInput.SetValue("")
+	m.confirmationInput.Placeholder = fmt.Sprintf("%d or logs", run.RunNumber)
+	return m.confirmationInput.Focus()
+}
+
+func (m *ModelDeleteRun) Close() {
+	m.confirmationInput.Blur()
+	m.visible = false
+}
+
+func (m *ModelDeleteRun) Visible() bool {
+	return m.visible
+}
+
+// Run returns the run which is deleted
+func (m *ModelDeleteRun) Run() gu.Workflow {
+	return m.run
+}
+
+// Target returns what the typed confirmation deletes, nothing if it doesn't match
+func (m *ModelDeleteRun) Target() deleteRunTarget {
+	switch confirmation := strings.TrimSpace(m.confirmationInput.Value()); confirmation {
+	case strconv.Itoa(m.run.RunNumber), "#" + strconv.Itoa(m.run.RunNumber):
+		return deleteRun
+	case "logs":
+		return deleteRunLogs
+	default:
+		return deleteNothing
+	}
+}
+
+func (m *ModelDeleteRun) Update(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	m.confirmationInput, cmd = m.confirmationInput.Update(msg)
+	return cmd
+}
+
+// View renders the confirmation of the deletion
+func (m *ModelDeleteRun) View() string {
+	width := m.skeleton.GetTerminalWidth() - 4
+
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Width(18)
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	warningStyle := lipgloss.NewStyle().Foreground(jobStateColors["failure"])
+
+	title := lipgloss.NewStyle().Foreground(lipgloss.Color("120")).Render(fmt.Sprintf("%s #%d", m.run.WorkflowName, m.run.RunNumber)) +
+		mutedStyle.Render("  "+m.run.ActionName)
+
+	lines := []string{
+		labelStyle.Render("Branch") + m.run.Branch,
+		labelStyle.Render("Triggered by") + m.run.TriggeredBy,
+		labelStyle.Render("Started") + m.run.StartedAt,
+		"",
+		warningStyle.Render("Deleted runs and logs cannot be restored."),
+		fmt.Sprintf("Type %d to delete the run and its logs, or logs to delete only its logs, then press enter",
+			m.run.RunNumber),
+	}
+
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("#3b698f")).
+		Width(width - 2).
+		MarginLeft(1)
+
+	lineStyle := lipgloss.NewStyle().MaxWidth(width - 2)
+	content := []string{lineStyle.Render(title), ""}
+	for _, line := range lines {
+		content = append(content, lineStyle.Render(line))
+	}
+
+	m.confirmationInput.Width = max(width-labelStyle.GetWidth()-6, 10)
+	content = append(content, "", lineStyle.Render(labelStyle.Render("Confirmation")+m.confirmationInput.View()))
+
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, content...))
+}
@@ -0,0 +1,478 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gu "github.com/termkit/gama/internal/github/usecase"
+	"github.com/termkit/gama/pkg/fuzzy"
+	"github.com/termkit/skeleton"
+)
+
+// -----------------------------------------------------------------------------
+// Model Definition
+// -----------------------------------------------------------------------------
+
+type ModelGithubDispatch struct {
+	// Core dependencies
+	skeleton *skeleton.Skeleton
+	github   gu.UseCase
+
+	// UI Components
+	help          help.Model
+	keys          githubDispatchKeyMap
+	status        *ModelStatus
+	eventInput    textinput.Model
+	payloadEditor textarea.Model
+
+	// Context management
+	syncEventsContext context.Context
+	cancelSyncEvents  context.CancelFunc
+
+	// Shared state
+	selectedRepository *SelectedRepository
+	currentRepository  string
+
+	// Event types found in the workflow files of the repository
+	eventTypes        []gu.RepositoryDispatchEventType
+	catchAllWorkflows []string
+
+	// Fuzzy matched event types shown under the event type input
+	suggestions      []fuzzy.Match
+	suggestionCursor int
+	lastEventInput   string
+
+	focus   dispatchFocus
+	sending bool
+}
+
+// dispatchFocus is the component which receives the key messages
+type dispatchFocus int
+
+const (
+	dispatchFocusEvent dispatchFocus = iota
+	dispatchFocusPayload
+	dispatchFocusSend
+)
+
+// defaultClientPayload is the initial content of the payload editor
+const defaultClientPayload = "{\n  \n}"
+
+// -----------------------------------------------------------------------------
+// Constructor & Initialization
+// -----------------------------------------------------------------------------
+
+func SetupModelGithubDispatch(s *skeleton.Skeleton, githubUseCase gu.UseCase) *ModelGithubDispatch {
+	m := &ModelGithubDispatch{
+		// Initialize core dependencies
+		skeleton: s,
+		github:   githubUseCase,
+
+		// Initialize UI components
+		help:          help.New(),
+		keys:          githubDispatchKeys,
+		status:        SetupModelStatus(s),
+		eventInput:    setupEventTypeInput(),
+		payloadEditor: setupPayloadEditor(),
+
+		// Initialize state
+		selectedRepository: NewSelectedRepository(),
+		syncEventsContext:  context.Background(),
+		cancelSyncEvents:   func() {},
+	}
+
+	m.setFocus(dispatchFocusEvent)
+
+	return m
+}
+
+func setupEventTypeInput() textinput.Model {
+	ti := textinput.New()
+	ti.CharLimit = 100 // GitHub rejects longer event types
+	ti.Placeholder = "Type an event type"
+	ti.Prompt = "event_type > "
+	return ti
+}
+
+func setupPayloadEditor() textarea.Model {
+	ta := textarea.New()
+	ta.Placeholder = "client_payload as a JSON object"
+	ta.ShowLineNumbers = true
+	ta.CharLimit = 0
+	ta.SetValue(defaultClientPayload)
+	ta.Blur()
+	return ta
+}
+
+// -----------------------------------------------------------------------------
+// Bubbletea Model Implementation
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubDispatch) Init() tea.Cmd {
+	return tea.Batch(textinput.Blink, textarea.Blink)
+}
+
+func (m *ModelGithubDispatch) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.handleRepositoryChange()
+
+	var cmds []tea.Cmd
+	var cmd tea.Cmd
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if m.handleKeys(keyMsg) {
+			// delete msg key to prevent it from reaching the inputs
+			msg = tea.KeyMsg{Type: tea.KeyNull}
+		}
+	}
+
+	switch m.focus {
+	case dispatchFocusEvent:
+		m.eventInput, cmd = m.eventInput.Update(msg)
+		cmds = append(cmds, cmd)
+		m.updateSuggestions()
+	case dispatchFocusPayload:
+		m.payloadEditor, cmd = m.payloadEditor.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+func (m *ModelGithubDispatch) View() string {
+	return lipgloss.JoinVertical(lipgloss.Top,
+		m.renderEventInput(),
+		m.renderSuggestions(),
+		m.renderPayloadEditor(),
+		lipgloss.JoinHorizontal(lipgloss.Top, m.renderPayloadInfo(), m.renderSendButton()),
+		m.status.View(),
+		m.renderHelp(),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// Repository Change Handling
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubDispatch) handleRepositoryChange() {
+	if m.selectedRepository.RepositoryName == "" || m.currentRepository == m.selectedRepository.RepositoryName {
+		return
+	}
+
+	m.currentRepository = m.selectedRepository.RepositoryName
+	m.eventInput.Reset()
+	m.payloadEditor.SetValue(defaultClientPayload)
+	m.setFocus(dispatchFocusEvent)
+	m.syncEventTypes()
+}
+
+// -----------------------------------------------------------------------------
+// Key Handling
+// -----------------------------------------------------------------------------
+
+// handleKeys handles the keys of the page, it reports whether the key is consumed
+func (m *ModelGithubDispatch) handleKeys(msg tea.KeyMsg) bool {
+	switch {
+	case key.Matches(msg, m.keys.Refresh):
+		m.syncEventTypes()
+		return true
+	case key.Matches(msg, m.keys.SwitchFocus):
+		m.setFocus((m.focus + 1) % 3)
+		return true
+	}
+
+	switch m.focus {
+	case dispatchFocusEvent:
+		if len(m.suggestions) == 0 {
+			return false
+		}
+		switch {
+		case key.Matches(msg, m.keys.NextSuggestion):
+			m.suggestionCursor = min(m.suggestionCursor+1, len(m.suggestions)-1)
+			return true
+		case key.Matches(msg, m.keys.PrevSuggestion):
+			m.suggestionCursor = max(m.suggestionCursor-1, 0)
+			return true
+		case key.Matches(msg, m.keys.AcceptSuggestion):
+			m.eventInput.SetValue(m.suggestions[m.suggestionCursor].Value)
+			m.eventInput.CursorEnd()
+			m.setFocus(dispatchFocusPayload)
+			return true
+		}
+	case dispatchFocusSend:
+		if key.Matches(msg, m.keys.Send) {
+			if !m.sending {
+				go m.sendDispatch()
+			}
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *ModelGithubDispatch) setFocus(focus dispatchFocus) {
+	m.focus = focus
+
+	m.eventInput.Blur()
+	m.payloadEditor.Blur()
+	switch focus {
+	case dispatchFocusEvent:
+		m.eventInput.Focus()
+	case dispatchFocusPayload:
+		m.payloadEditor.Focus()
+	}
+
+	// Show only the bindings of the focused component in the help
+	m.keys.NextSuggestion.SetEnabled(focus == dispatchFocusEvent)
+	m.keys.PrevSuggestion.SetEnabled(focus == dispatchFocusEvent)
+	m.keys.AcceptSuggestion.SetEnabled(focus == dispatchFocusEvent)
+	m.keys.Send.SetEnabled(focus == dispatchFocusSend)
+}
+
+// -----------------------------------------------------------------------------
+// Event Type Suggestions
+// -----------------------------------------------------------------------------
+
+// updateSuggestions refreshes the suggestions when the typed event type changes
+func (m *ModelGithubDispatch) updateSuggestions() {
+	if m.eventInput.Value() == m.lastEventInput {
+		return
+	}
+	m.refreshSuggestions()
+}
+
+func (m *ModelGithubDispatch) refreshSuggestions() {
+	value := m.eventInput.Value()
+	m.lastEventInput = value
+	m.suggestionCursor = 0
+
+	names := make([]string, len(m.eventTypes))
+	for i, eventType := range m.eventTypes {
+		names[i] = eventType.Name
+	}
+
+	// All event types are suggested while the input is empty
+	m.suggestions = fuzzy.Find(value, names)
+
+	// Nothing to suggest if the typed event type is the only match
+	if len(m.suggestions) == 1 && m.suggestions[0].Value == value {
+		m.suggestions = nil
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Event Type Sync
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubDispatch) syncEventTypes() {
+	m.cancelSyncEvents()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.syncEventsContext = ctx
+	m.cancelSyncEvents = cancel
+
+	go func() {
+		defer m.skeleton.TriggerUpdate()
+
+		m.status.Reset()
+		m.status.SetProgressMessage(fmt.Sprintf("[%s] Scanning workflows for repository_dispatch event types...",
+			m.selectedRepository.RepositoryName))
+
+		events, err := m.github.GetRepositoryDispatchEvents(ctx, gu.GetRepositoryDispatchEventsInput{
+			Repository: m.selectedRepository.RepositoryName,
+		})
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				m.status.SetError(err)
+				m.status.SetErrorMessage("Event types cannot be listed")
+			}
+			return
+		}
+
+		m.eventTypes = events.EventTypes
+		m.catchAllWorkflows = events.CatchAllWorkflows
+		m.refreshSuggestions()
+
+		switch {
+		case len(m.eventTypes) == 0 && len(m.catchAllWorkflows) == 0:
+			m.status.SetDefaultMessage(fmt.Sprintf("[%s] No workflow listens for repository_dispatch.",
+				m.selectedRepository.RepositoryName))
+		case len(m.catchAllWorkflows) > 0:
+			m.status.SetSuccessMessage(fmt.Sprintf("[%s] %d event types found, %s run for every event type.",
+				m.selectedRepository.RepositoryName, len(m.eventTypes), strings.Join(m.catchAllWorkflows, ", ")))
+		default:
+			m.status.SetSuccessMessage(fmt.Sprintf("[%s] %d event types found.",
+				m.selectedRepository.RepositoryName, len(m.eventTypes)))
+		}
+	}()
+}
+
+// -----------------------------------------------------------------------------
+// Sending
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubDispatch) sendDispatch() {
+	m.sending = true
+	defer func() {
+		m.sending = false
+		m.skeleton.TriggerUpdate()
+	}()
+
+	eventType := strings.TrimSpace(m.eventInput.Value())
+
+	m.status.SetProgressMessage(fmt.Sprintf("[%s]:[%s] Sending repository_dispatch event...",
+		m.selectedRepository.RepositoryName, eventType))
+
+	if err := m.github.CreateRepositoryDispatch(context.Background(), gu.CreateRepositoryDispatchInput{
+		Repository:    m.selectedRepository.RepositoryName,
+		EventType:     eventType,
+		ClientPayload: m.payloadEditor.Value(),
+	}); err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage("Event cannot be sent")
+		return
+	}
+
+	m.status.SetSuccessMessage(fmt.Sprintf("[%s]:[%s] Event sent.",
+		m.selectedRepository.RepositoryName, eventType))
+}
+
+// validatePayload checks the payload the same way it is checked before sending
+func (m *ModelGithubDispatch) validatePayload() error {
+	return gu.ValidateClientPayload(m.payloadEditor.Value())
+}
+
+// -----------------------------------------------------------------------------
+// UI Rendering
+// -----------------------------------------------------------------------------
+
+// maxVisibleEventTypes is the number of event types rendered under the event type input at once
+const maxVisibleEventTypes = 5
+
+func (m *ModelGithubDispatch) borderColor(focus dispatchFocus) lipgloss.Color {
+	if m.focus == focus {
+		return "#3b698f"
+	}
+	return "240"
+}
+
+func (m *ModelGithubDispatch) renderEventInput() string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(m.borderColor(dispatchFocusEvent)).
+		Padding(0, 1).
+		Width(m.skeleton.GetTerminalWidth() - 6).
+		MarginLeft(1)
+
+	return style.Render(m.eventInput.View())
+}
+
+func (m *ModelGithubDispatch) suggestionsHeight() int {
+	if len(m.suggestions) == 0 {
+		return 0
+	}
+	return min(len(m.suggestions), maxVisibleEventTypes) + 2 // borders
+}
+
+func (m *ModelGithubDispatch) renderSuggestions() string {
+	if len(m.suggestions) == 0 {
+		return ""
+	}
+
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		Padding(0, 1).
+		Width(m.skeleton.GetTerminalWidth() - 6).
+		MarginLeft(1)
+
+	selectedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("229")).Background(lipgloss.Color("57"))
+	workflowStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+	// Scroll the visible window with the cursor
+	start := max(0, m.suggestionCursor-maxVisibleEventTypes+1)
+	end := min(len(m.suggestions), start+maxVisibleEventTypes)
+
+	var lines []string
+	for i := start; i < end; i++ {
+		suggestion := m.suggestions[i]
+
+		line := suggestion.Value
+		if i == m.suggestionCursor && m.focus == dispatchFocusEvent {
+			line = selectedStyle.Render(line)
+		}
+		if suggestion.Index < len(m.eventTypes) {
+			line += workflowStyle.Render(" → " + strings.Join(m.eventTypes[suggestion.Index].Workflows, ", "))
+		}
+
+		lines = append(lines, line)
+	}
+
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+func (m *ModelGithubDispatch) renderPayloadEditor() string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(m.borderColor(dispatchFocusPayload)).
+		MarginLeft(1)
+
+	if m.validatePayload() != nil {
+		style = style.BorderForeground(lipgloss.Color("9"))
+	}
+
+	// Event input, payload info, status and help take 3 lines each, the editor takes the rest
+	m.payloadEditor.SetWidth(m.skeleton.GetTerminalWidth() - 6)
+	m.payloadEditor.SetHeight(max(m.skeleton.GetTerminalHeight()-20-m.suggestionsHeight(), 3))
+
+	return style.Render(m.payloadEditor.View())
+}
+
+func (m *ModelGithubDispatch) renderPayloadInfo() string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("#3b698f")).
+		Padding(0, 1).
+		Width(m.skeleton.GetTerminalWidth() - 14).
+		MarginLeft(1)
+
+	if err := m.validatePayload(); err != nil {
+		return style.BorderForeground(lipgloss.Color("9")).
+			Render(lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render(err.Error()))
+	}
+
+	info := "client_payload is a valid JSON object"
+	if strings.TrimSpace(m.payloadEditor.Value()) == "" {
+		info = "client_payload is empty, the event is sent without a payload"
+	}
+	return style.Render(info)
+}
+
+func (m *ModelGithubDispatch) renderSendButton() string {
+	button := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("255")).
+		Padding(0, 1).
+		Align(lipgloss.Center)
+
+	if m.focus == dispatchFocusSend {
+		button = button.BorderForeground(lipgloss.Color("#399adb")).
+			Foreground(lipgloss.Color("#399adb")).
+			BorderStyle(lipgloss.DoubleBorder())
+	}
+
+	return button.Render("Send")
+}
+
+func (m *ModelGithubDispatch) renderHelp() string {
+	helpStyle := WindowStyleHelp.Width(m.skeleton.GetTerminalWidth() - 4)
+	return helpStyle.Render(m.ViewHelp())
+}
@@ -0,0 +1,401 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/termkit/gama/internal/config"
+	gu "github.com/termkit/gama/internal/github/usecase"
+	"github.com/termkit/gama/pkg/browser"
+	"github.com/termkit/skeleton"
+)
+
+// -----------------------------------------------------------------------------
+// Model Definition
+// -----------------------------------------------------------------------------
+
+// ModelGithubMultiHistory lists the runs of the repositories set in settings.multi_repo in one table
+type ModelGithubMultiHistory struct {
+	// Core dependencies
+	skeleton *skeleton.Skeleton
+	github   gu.UseCase
+
+	// UI Components
+	Help              help.Model
+	keys              githubMultiHistoryKeyMap
+	tableMultiHistory table.Model
+	status            *ModelStatus
+	modelTabOptions   *ModelTabOptions
+	columnPicker      *ModelColumnPicker
+
+	// Table state
+	tableStyle lipgloss.Style
+	workflows  []gu.Workflow
+	columns    []tableColumn[gu.Workflow] // columns of the table in their order, they are set in the config
+	sort       tableSort
+
+	// Repositories of the history
+	repositories  []string
+	organizations []string
+	workers       int
+	fetched       bool // the runs are fetched the first time the tab is opened
+
+	// Live mode state
+	liveMode         *LiveMode
+	liveModeInterval time.Duration
+
+	// Workflow state
+	selectedWorkflow gu.Workflow
+
+	// Context management
+	syncMultiHistoryContext context.Context
+	cancelSyncMultiHistory  context.CancelFunc
+}
+
+type multiHistoryUpdateMsg struct {
+	UpdateAfter time.Duration
+}
+
+// -----------------------------------------------------------------------------
+// Constructor & Initialization
+// -----------------------------------------------------------------------------
+
+func SetupModelGithubMultiHistory(s *skeleton.Skeleton, githubUseCase gu.UseCase) *ModelGithubMultiHistory {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		panic(fmt.Sprintf("failed to load config: %v", err))
+	}
+
+	columns := cfg.Settings.Columns.MultiRepo
+	if len(columns) == 0 {
+		columns = multiHistoryColumns
+	}
+
+	modelStatus := SetupModelStatus(s)
+	m := &ModelGithubMultiHistory{
+		// Initialize core dependencies
+		skeleton: s,
+		github:   githubUseCase,
+
+		// Initialize UI components
+		Help:            help.New(),
+		keys:            githubMultiHistoryKeys,
+		status:          modelStatus,
+		modelTabOptions: NewOptions(s, modelStatus),
+		columnPicker:    SetupModelColumnPicker(),
+
+		// Initialize state
+		repositories:            cfg.Settings.MultiRepo.Repositories,
+		organizations:           cfg.Settings.MultiRepo.Organizations,
+		workers:                 cfg.Settings.MultiRepo.Workers,
+		liveMode:                NewLiveMode(s, cfg.Settings.LiveMode.Enabled),
+		liveModeInterval:        cfg.Settings.LiveMode.Interval,
+		tableStyle:              setupTableStyle(),
+		columns:                 selectColumns(workflowHistoryColumns, columns),
+		sort:                    newTableSort(),
+		syncMultiHistoryContext: context.Background(),
+		cancelSyncMultiHistory:  func() {},
+	}
+
+	m.tableMultiHistory = setupWorkflowHistoryTable(fitColumns(m.columns, m.sort, 0))
+
+	return m
+}
+
+// -----------------------------------------------------------------------------
+// Bubbletea Model Implementation
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubMultiHistory) Init() tea.Cmd {
+	m.setupOptions()
+	m.startLiveMode()
+	return tea.Batch(m.modelTabOptions.Init())
+}
+
+func (m *ModelGithubMultiHistory) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if !m.fetched {
+		m.fetched = true
+		m.startSync(0)
+	}
+
+	cursor := m.tableMultiHistory.Cursor()
+	if cursor >= 0 && cursor < len(m.workflows) {
+		m.selectedWorkflow = m.workflows[cursor]
+	} else {
+		m.selectedWorkflow = gu.Workflow{}
+	}
+
+	// The column picker takes the keys while it is open
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.columnPicker.Visible() {
+		m.handleColumnPickerKeyMsg(keyMsg)
+		return m, nil
+	}
+
+	var cmds []tea.Cmd
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if cmd = m.handleKeyMsg(msg); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	case multiHistoryUpdateMsg:
+		m.startSync(msg.UpdateAfter)
+	}
+
+	m.tableMultiHistory, cmd = m.tableMultiHistory.Update(msg)
+	cmds = append(cmds, cmd)
+
+	m.modelTabOptions, cmd = m.modelTabOptions.Update(msg)
+	cmds = append(cmds, cmd)
+
+	return m, tea.Batch(cmds...)
+}
+
+func (m *ModelGithubMultiHistory) View() string {
+	helpStyle := WindowStyleHelp.Width(m.skeleton.GetTerminalWidth() - 4)
+
+	if m.columnPicker.Visible() {
+		return lipgloss.JoinVertical(lipgloss.Top,
+			m.columnPicker.View(m.skeleton.GetTerminalWidth()-6),
+			m.status.View(),
+			helpStyle.Render(m.columnPicker.ViewHelp()),
+		)
+	}
+
+	m.updateTableDimensions()
+	return lipgloss.JoinVertical(lipgloss.Top,
+		m.tableStyle.Render(m.tableMultiHistory.View()),
+		m.modelTabOptions.View(),
+		m.status.View(),
+		helpStyle.Render(m.ViewHelp()),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// Event Handlers
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubMultiHistory) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, m.keys.Refresh):
+		m.startSync(0)
+	case key.Matches(msg, m.keys.LiveMode):
+		m.toggleLiveMode()
+	case key.Matches(msg, m.keys.SortBy):
+		m.sort.next(len(m.columns))
+		m.resortTable()
+	case key.Matches(msg, m.keys.SortOrder):
+		m.sort.reverse()
+		m.resortTable()
+	case key.Matches(msg, m.keys.Columns):
+		m.columnPicker.Open("Columns of the multi-repo history", columnChoices(workflowHistoryColumns, m.columns))
+	}
+	return nil
+}
+
+func (m *ModelGithubMultiHistory) handleColumnPickerKeyMsg(msg tea.KeyMsg) {
+	if m.columnPicker.Update(msg) != columnPickerSaved {
+		return
+	}
+
+	m.columns = selectColumns(workflowHistoryColumns, m.columnPicker.Keys())
+	m.sort = newTableSort()
+
+	// The rows have to match the columns, they are cleared before the columns are replaced
+	m.tableMultiHistory.SetRows([]table.Row{})
+	m.tableMultiHistory.SetColumns(fitColumns(m.columns, m.sort, 0))
+	m.updateTableDimensions()
+	m.updateTable()
+
+	if err := config.SaveColumns("multi_repo", columnKeys(m.columns)); err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage(fmt.Sprintf("Columns cannot be saved: %v", err))
+		return
+	}
+	m.status.SetSuccessMessage("Columns saved to the config")
+}
+
+// resortTable sorts the table again and keeps the cursor on the selected run
+func (m *ModelGithubMultiHistory) resortTable() {
+	m.updateTableDimensions()
+	m.updateTable()
+	for i, workflow := range m.workflows {
+		if workflow.ID == m.selectedWorkflow.ID {
+			m.tableMultiHistory.SetCursor(i)
+			break
+		}
+	}
+	m.status.SetDefaultMessage(m.sort.describe(m.tableMultiHistory.Columns()))
+}
+
+// -----------------------------------------------------------------------------
+// Live Mode Management
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubMultiHistory) startLiveMode() {
+	ticker := time.NewTicker(m.liveModeInterval)
+	go func() {
+		defer ticker.Stop()
+		for range ticker.C {
+			if m.liveMode.Enabled() {
+				// The message is only sent to the active tab, the runs aren't fetched while another tab is open
+				m.skeleton.TriggerUpdateWithMsg(multiHistoryUpdateMsg{
+					UpdateAfter: time.Nanosecond,
+				})
+			}
+		}
+	}()
+}
+
+func (m *ModelGithubMultiHistory) toggleLiveMode() {
+	message := "Live mode disabled"
+
+	if m.liveMode.Toggle() {
+		message = "Live mode enabled"
+		// Trigger immediate update when enabling
+		m.startSync(0)
+	}
+
+	m.status.SetSuccessMessage(message)
+}
+
+// -----------------------------------------------------------------------------
+// Multi-repo History Sync
+// -----------------------------------------------------------------------------
+
+// startSync cancels the running fetch and fetches the runs again after the delay, a cancelled fetch would show
+// older runs. It is called from Update only, so the cancel func is not replaced by two fetches at once.
+func (m *ModelGithubMultiHistory) startSync(after time.Duration) {
+	m.cancelSyncMultiHistory()
+
+	var ctx context.Context
+	ctx, m.cancelSyncMultiHistory = context.WithCancel(m.syncMultiHistoryContext)
+	go func() {
+		time.Sleep(after)
+		if ctx.Err() == nil {
+			m.syncMultiHistory(ctx)
+		}
+	}()
+}
+
+func (m *ModelGithubMultiHistory) syncMultiHistory(ctx context.Context) {
+	defer m.skeleton.TriggerUpdate()
+
+	if len(m.repositories) == 0 && len(m.organizations) == 0 {
+		m.modelTabOptions.SetStatus(StatusNone)
+		m.status.SetDefaultMessage("No repositories set, add them to settings.multi_repo in the config")
+		return
+	}
+
+	// Organizations may have many repositories, they take longer than a single repository
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	m.status.Reset()
+	m.status.SetProgressMessage("Fetching workflow history of the repositories...")
+	m.modelTabOptions.SetStatus(StatusWait)
+
+	history, err := m.github.GetMultiRepositoryHistory(ctx, gu.GetMultiRepositoryHistoryInput{
+		Repositories:  m.repositories,
+		Organizations: m.organizations,
+		Workers:       m.workers,
+	})
+	if err != nil {
+		m.modelTabOptions.SetStatus(StatusNone)
+		switch {
+		case errors.Is(err, context.Canceled):
+			m.status.SetDefaultMessage("Workflow history fetch cancelled")
+		case errors.Is(err, context.DeadlineExceeded):
+			m.status.SetErrorMessage("Workflow history fetch timed out")
+		default:
+			m.status.SetError(err)
+			m.status.SetErrorMessage(fmt.Sprintf("Failed to fetch workflow history: %v", err))
+		}
+		return
+	}
+
+	m.workflows = history.Workflows
+	m.updateTable()
+	m.tableMultiHistory.SetCursor(0)
+	m.modelTabOptions.SetStatus(StatusIdle)
+
+	message := fmt.Sprintf("Workflow history of %d repositories fetched, %d runs.", len(history.Repositories), len(history.Workflows))
+	if len(history.Failures) > 0 {
+		var failed []string
+		for _, failure := range history.Failures {
+			failed = append(failed, failure.Repository)
+		}
+		m.status.SetError(history.Failures[0].Err)
+		m.status.SetErrorMessage(fmt.Sprintf("%s Runs of %s cannot be fetched", message, strings.Join(failed, ", ")))
+		return
+	}
+	m.status.SetSuccessMessage(message)
+}
+
+func (m *ModelGithubMultiHistory) updateTable() {
+	rows := columnRows(m.columns, m.workflows)
+	sortTable(m.sort, rows, m.workflows)
+	m.tableMultiHistory.SetRows(rows)
+}
+
+// -----------------------------------------------------------------------------
+// UI Rendering
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubMultiHistory) updateTableDimensions() {
+	const (
+		minTableWidth = 80 // Minimum width to maintain readability
+		tablePadding  = 18 // Account for borders and margins
+	)
+
+	termWidth := m.skeleton.GetTerminalWidth()
+	termHeight := m.skeleton.GetTerminalHeight()
+
+	if termWidth <= minTableWidth {
+		return // Prevent table from becoming too narrow
+	}
+
+	// Extra width is shared by the flex columns, like the repository and the workflow name
+	m.tableMultiHistory.SetColumns(fitColumns(m.columns, m.sort, termWidth-tablePadding))
+
+	if maxHeight := termHeight - 17; maxHeight > 0 {
+		m.tableMultiHistory.SetHeight(maxHeight)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Option Management
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubMultiHistory) setupOptions() {
+	m.modelTabOptions.AddOption("Browse", m.openInBrowser)
+}
+
+func (m *ModelGithubMultiHistory) openInBrowser() {
+	if m.selectedWorkflow.ID == 0 {
+		m.status.SetErrorMessage("No workflow selected")
+		return
+	}
+
+	m.status.SetProgressMessage("Opening in browser...")
+
+	url := fmt.Sprintf("https://github.com/%s/actions/runs/%d",
+		m.selectedWorkflow.Repository,
+		m.selectedWorkflow.ID)
+
+	if err := browser.OpenInBrowser(url); err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage("Failed to open in browser")
+		return
+	}
+	m.status.SetSuccessMessage("Opened in browser")
+}
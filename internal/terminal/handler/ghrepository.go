@@ -14,6 +14,7 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/termkit/gama/internal/config"
 	"github.com/termkit/gama/internal/github/domain"
 	gu "github.com/termkit/gama/internal/github/usecase"
 	"github.com/termkit/gama/pkg/browser"
@@ -32,6 +33,12 @@ type ModelGithubRepository struct {
 	// UI State
 	tableReady bool
 
+	// Table state, visibleRepositories are the rows of the table, the repositories which match the search
+	repositories        []gu.GithubRepository
+	visibleRepositories []gu.GithubRepository
+	columns             []tableColumn[gu.GithubRepository] // columns of the table in their order, they are set in the config
+	sort                tableSort
+
 	// Context management
 	syncRepositoriesContext context.Context
 	cancelSyncRepositories  context.CancelFunc
@@ -40,13 +47,13 @@ type ModelGithubRepository struct {
 	selectedRepository *SelectedRepository
 
 	// UI Components
-	help                        help.Model
-	Keys                        githubRepositoryKeyMap
-	tableGithubRepository       table.Model
-	searchTableGithubRepository table.Model
-	status                      *ModelStatus
-	textInput                   textinput.Model
-	modelTabOptions             *ModelTabOptions
+	help                  help.Model
+	Keys                  githubRepositoryKeyMap
+	tableGithubRepository table.Model
+	status                *ModelStatus
+	textInput             textinput.Model
+	modelTabOptions       *ModelTabOptions
+	columnPicker          *ModelColumnPicker
 }
 
 // -----------------------------------------------------------------------------
@@ -54,6 +61,11 @@ type ModelGithubRepository struct {
 // -----------------------------------------------------------------------------
 
 func SetupModelGithubRepository(s *skeleton.Skeleton, githubUseCase gu.UseCase) *ModelGithubRepository {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		panic(fmt.Sprintf("failed to load config: %v", err))
+	}
+
 	modelStatus := SetupModelStatus(s)
 	tabOptions := NewOptions(s, modelStatus)
 
@@ -68,23 +80,25 @@ func SetupModelGithubRepository(s *skeleton.Skeleton, githubUseCase gu.UseCase)
 		status:          modelStatus,
 		textInput:       setupTextInput(),
 		modelTabOptions: tabOptions,
+		columnPicker:    SetupModelColumnPicker(),
 
 		// Initialize state
 		selectedRepository:      NewSelectedRepository(),
 		syncRepositoriesContext: context.Background(),
 		cancelSyncRepositories:  func() {},
+		columns:                 selectColumns(repositoryColumns, cfg.Settings.Columns.Repositories),
+		sort:                    newTableSort(),
 	}
 
-	// Setup tables
-	m.tableGithubRepository = setupMainTable()
-	m.searchTableGithubRepository = setupSearchTable()
+	// Setup table
+	m.tableGithubRepository = setupMainTable(fitColumns(m.columns, m.sort, 0))
 
 	return m
 }
 
-func setupMainTable() table.Model {
+func setupMainTable(columns []table.Column) table.Model {
 	t := table.New(
-		table.WithColumns(tableColumnsGithubRepository),
+		table.WithColumns(columns),
 		table.WithRows([]table.Row{}),
 		table.WithFocused(true),
 		table.WithHeight(13),
@@ -99,13 +113,6 @@ func setupMainTable() table.Model {
 	return t
 }
 
-func setupSearchTable() table.Model {
-	return table.New(
-		table.WithColumns(tableColumnsGithubRepository),
-		table.WithRows([]table.Row{}),
-	)
-}
-
 func setupTextInput() textinput.Model {
 	ti := textinput.New()
 	ti.Blur()
@@ -139,6 +146,26 @@ func (m *ModelGithubRepository) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.tableGithubRepository.SetCursor(0)
 		return m, nil
 	case tea.KeyMsg:
+		// The column picker takes the keys while it is open
+		if m.columnPicker.Visible() {
+			m.handleColumnPickerKeyMsg(msg)
+			return m, nil
+		}
+
+		switch {
+		case key.Matches(msg, m.Keys.SortBy):
+			m.sort.next(len(m.columns))
+			m.resortTable()
+			return m, nil
+		case key.Matches(msg, m.Keys.SortOrder):
+			m.sort.reverse()
+			m.resortTable()
+			return m, nil
+		case key.Matches(msg, m.Keys.Columns):
+			m.columnPicker.Open("Columns of the repositories", columnChoices(repositoryColumns, m.columns))
+			return m, nil
+		}
+
 		// Handle number keys for tab options
 		if m.isNumber(msg.String()) {
 			inputMsg = tea.KeyMsg{}
@@ -190,10 +217,6 @@ func (m *ModelGithubRepository) updateTable(msg tea.Msg) tea.Cmd {
 	m.tableGithubRepository, cmd = m.tableGithubRepository.Update(msg)
 	cmds = append(cmds, cmd)
 
-	// Update search table
-	m.searchTableGithubRepository, cmd = m.searchTableGithubRepository.Update(msg)
-	cmds = append(cmds, cmd)
-
 	// Handle table selection
 	m.handleTableInputs(m.syncRepositoriesContext)
 
@@ -204,6 +227,14 @@ func (m *ModelGithubRepository) updateTable(msg tea.Msg) tea.Cmd {
 }
 
 func (m *ModelGithubRepository) View() string {
+	if m.columnPicker.Visible() {
+		return lipgloss.JoinVertical(lipgloss.Top,
+			m.columnPicker.View(m.skeleton.GetTerminalWidth()-6),
+			m.status.View(),
+			WindowStyleHelp.Width(m.skeleton.GetTerminalWidth()-4).Render(m.columnPicker.ViewHelp()),
+		)
+	}
+
 	return lipgloss.JoinVertical(lipgloss.Top,
 		m.renderTable(),
 		m.renderSearchBar(),
@@ -287,45 +318,67 @@ func (m *ModelGithubRepository) fetchRepositories(ctx context.Context) (*gu.List
 
 func (m *ModelGithubRepository) clearTables() {
 	m.tableGithubRepository.SetRows([]table.Row{})
-	m.searchTableGithubRepository.SetRows([]table.Row{})
+	m.repositories = nil
+	m.visibleRepositories = nil
 }
 
 func (m *ModelGithubRepository) updateTableDimensions() {
 	const minTableWidth = 60 // Minimum width to maintain readability
 	const tablePadding = 14  // Account for borders and margins
 
-	var tableWidth int
-	for _, t := range tableColumnsGithubRepository {
-		tableWidth += t.Width
-	}
-
 	termWidth := m.skeleton.GetTerminalWidth()
 	if termWidth <= minTableWidth {
 		return // Prevent table from becoming too narrow
 	}
 
-	newTableColumns := make([]table.Column, len(tableColumnsGithubRepository))
-	copy(newTableColumns, tableColumnsGithubRepository)
-
-	widthDiff := termWidth - tableWidth - tablePadding
-	if widthDiff > 0 {
-		// Add extra width to repository name column
-		newTableColumns[0].Width += widthDiff
-		m.tableGithubRepository.SetColumns(newTableColumns)
+	// Extra width goes to the repository name column
+	m.tableGithubRepository.SetColumns(fitColumns(m.columns, m.sort, termWidth-tablePadding))
 
-		// Adjust height while maintaining some padding
-		maxHeight := m.skeleton.GetTerminalHeight() - 20
-		if maxHeight > 0 {
-			m.tableGithubRepository.SetHeight(maxHeight)
-		}
+	// Adjust height while maintaining some padding
+	maxHeight := m.skeleton.GetTerminalHeight() - 20
+	if maxHeight > 0 {
+		m.tableGithubRepository.SetHeight(maxHeight)
 	}
 }
 
 func (m *ModelGithubRepository) resetTableCursors() {
 	m.tableGithubRepository.GotoTop()
 	m.tableGithubRepository.SetCursor(0)
-	m.searchTableGithubRepository.GotoTop()
-	m.searchTableGithubRepository.SetCursor(0)
+}
+
+// resortTable sorts the table again and keeps the cursor on the selected repository
+func (m *ModelGithubRepository) resortTable() {
+	m.updateTableDimensions()
+	m.updateTableRowsBySearchBar()
+	for i, repository := range m.visibleRepositories {
+		if repository.Name == m.selectedRepository.RepositoryName {
+			m.tableGithubRepository.SetCursor(i)
+			break
+		}
+	}
+	m.status.SetDefaultMessage(m.sort.describe(m.tableGithubRepository.Columns()))
+}
+
+func (m *ModelGithubRepository) handleColumnPickerKeyMsg(msg tea.KeyMsg) {
+	if m.columnPicker.Update(msg) != columnPickerSaved {
+		return
+	}
+
+	m.columns = selectColumns(repositoryColumns, m.columnPicker.Keys())
+	m.sort = newTableSort()
+
+	// The rows have to match the columns, they are cleared before the columns are replaced
+	m.tableGithubRepository.SetRows([]table.Row{})
+	m.tableGithubRepository.SetColumns(fitColumns(m.columns, m.sort, 0))
+	m.updateTableDimensions()
+	m.updateTableRowsBySearchBar()
+
+	if err := config.SaveColumns("repositories", columnKeys(m.columns)); err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage(fmt.Sprintf("Columns cannot be saved: %v", err))
+		return
+	}
+	m.status.SetSuccessMessage("Columns saved to the config")
 }
 
 // -----------------------------------------------------------------------------
@@ -346,23 +399,12 @@ func (m *ModelGithubRepository) updateRepositoryData(repos *gu.ListRepositoriesO
 }
 
 func (m *ModelGithubRepository) updateTableRows(repositories []gu.GithubRepository) {
-	rows := make([]table.Row, 0, len(repositories))
-	for _, repo := range repositories {
-		rows = append(rows, table.Row{
-			repo.Name,
-			repo.DefaultBranch,
-			strconv.Itoa(repo.Stars),
-			strconv.Itoa(len(repo.Workflows)),
-		})
-	}
-
-	m.tableGithubRepository.SetRows(rows)
-	m.searchTableGithubRepository.SetRows(rows)
+	m.repositories = repositories
+	m.updateTableRowsBySearchBar()
 }
 
 func (m *ModelGithubRepository) finalizeTableUpdate() {
 	m.tableGithubRepository.SetCursor(0)
-	m.searchTableGithubRepository.SetCursor(0)
 	m.tableReady = true
 	m.textInput.Focus()
 	m.status.SetSuccessMessage("Repositories fetched")
@@ -376,23 +418,20 @@ func (m *ModelGithubRepository) finalizeTableUpdate() {
 
 func (m *ModelGithubRepository) updateTableRowsBySearchBar() {
 	searchValue := strings.ToLower(m.textInput.Value())
-	if searchValue == "" {
-		// If search is empty, restore original rows
-		m.tableGithubRepository.SetRows(m.searchTableGithubRepository.Rows())
-		return
-	}
-
-	rows := m.searchTableGithubRepository.Rows()
-	filteredRows := make([]table.Row, 0, len(rows))
 
-	for _, row := range rows {
-		if strings.Contains(strings.ToLower(row[0]), searchValue) {
-			filteredRows = append(filteredRows, row)
+	visibleRepositories := make([]gu.GithubRepository, 0, len(m.repositories))
+	for _, repository := range m.repositories {
+		if strings.Contains(strings.ToLower(repository.Name), searchValue) {
+			visibleRepositories = append(visibleRepositories, repository)
 		}
 	}
 
-	m.tableGithubRepository.SetRows(filteredRows)
-	if len(filteredRows) == 0 {
+	rows := columnRows(m.columns, visibleRepositories)
+	sortTable(m.sort, rows, visibleRepositories)
+	m.visibleRepositories = visibleRepositories
+	m.tableGithubRepository.SetRows(rows)
+
+	if searchValue != "" && len(rows) == 0 {
 		m.clearSelectedRepository()
 	}
 }
@@ -400,6 +439,7 @@ func (m *ModelGithubRepository) updateTableRowsBySearchBar() {
 func (m *ModelGithubRepository) clearSelectedRepository() {
 	m.selectedRepository.RepositoryName = ""
 	m.selectedRepository.BranchName = ""
+	m.selectedRepository.BranchIsTag = false
 	m.selectedRepository.WorkflowName = ""
 }
 
@@ -470,23 +510,21 @@ func (m *ModelGithubRepository) handleTableInputs(_ context.Context) {
 		return
 	}
 
-	selectedRow := m.tableGithubRepository.SelectedRow()
-	if len(selectedRow) > 0 && selectedRow[0] != "" {
-		m.updateSelectedRepository(selectedRow)
+	cursor := m.tableGithubRepository.Cursor()
+	if cursor >= 0 && cursor < len(m.visibleRepositories) {
+		m.updateSelectedRepository(m.visibleRepositories[cursor])
 	}
 }
 
-func (m *ModelGithubRepository) updateSelectedRepository(row []string) {
-	m.selectedRepository.RepositoryName = row[0]
-	m.selectedRepository.BranchName = row[1]
+func (m *ModelGithubRepository) updateSelectedRepository(repository gu.GithubRepository) {
+	m.selectedRepository.RepositoryName = repository.Name
+	m.selectedRepository.BranchName = repository.DefaultBranch
+	m.selectedRepository.BranchIsTag = false
 
-	if workflowCount := row[3]; workflowCount != "" {
-		m.handleWorkflowTabLocking(workflowCount)
-	}
+	m.handleWorkflowTabLocking(len(repository.Workflows))
 }
 
-func (m *ModelGithubRepository) handleWorkflowTabLocking(workflowCount string) {
-	count, _ := strconv.Atoi(workflowCount)
+func (m *ModelGithubRepository) handleWorkflowTabLocking(count int) {
 	if count == 0 {
 		m.skeleton.LockTab("workflow")
 		m.skeleton.LockTab("trigger")
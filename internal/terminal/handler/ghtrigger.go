@@ -5,19 +5,28 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	gu "github.com/termkit/gama/internal/github/usecase"
 	"github.com/termkit/gama/pkg/workflow"
+	py "github.com/termkit/gama/pkg/yaml"
 	"github.com/termkit/skeleton"
 )
 
+// inputDetailHeight is the height of the input detail pane including its borders
+const inputDetailHeight = 5
+
+// jobsSummaryHeight is the height of the line which summarizes the jobs a run would start
+const jobsSummaryHeight = 1
+
 type ModelGithubTrigger struct {
 	skeleton *skeleton.Skeleton
 
@@ -36,6 +45,9 @@ type ModelGithubTrigger struct {
 	selectedRepositoryName string
 	triggerFocused         bool
 
+	// jsonKeyPrompt is set while the name of a key added to a JSON object is typed
+	jsonKeyPrompt bool
+
 	// shared properties
 	selectedRepository *SelectedRepository
 
@@ -50,6 +62,7 @@ type ModelGithubTrigger struct {
 	status       *ModelStatus
 	textInput    textinput.Model
 	tableTrigger table.Model
+	jobsPreview  *ModelJobsPreview
 }
 
 func SetupModelGithubTrigger(sk *skeleton.Skeleton, githubUseCase gu.UseCase) *ModelGithubTrigger {
@@ -82,6 +95,7 @@ func SetupModelGithubTrigger(sk *skeleton.Skeleton, githubUseCase gu.UseCase) *M
 		status:              modelStatus,
 		tableTrigger:        tableTrigger,
 		textInput:           ti,
+		jobsPreview:         SetupModelJobsPreview(sk),
 		syncWorkflowContext: context.Background(),
 		cancelSyncWorkflow:  func() {},
 	}
@@ -106,6 +120,7 @@ func (m *ModelGithubTrigger) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.tableReady = false
 		m.isTriggerable = false
 		m.triggerFocused = false
+		m.jobsPreview.Close()
 
 		m.cancelSyncWorkflow() // cancel previous sync workflow
 
@@ -120,6 +135,31 @@ func (m *ModelGithubTrigger) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 	var cmd tea.Cmd
 
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && key.Matches(keyMsg, m.Keys.JobsPreview) {
+		if m.workflowContent != nil {
+			m.jobsPreview.Toggle()
+		}
+		return m, nil
+	}
+
+	// The jobs preview takes the keys while it is open
+	if m.jobsPreview.Visible() {
+		if _, ok := msg.(tea.KeyMsg); ok {
+			m.jobsPreview, cmd = m.jobsPreview.Update(msg)
+			return m, cmd
+		}
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.handleJSONKeyMsg(keyMsg) {
+		return m, nil
+	}
+
+	// The name of a new key is typed into the input, it is not the value of the selected key
+	if m.jsonKeyPrompt {
+		m.textInput, cmd = m.textInput.Update(msg)
+		return m, cmd
+	}
+
 	switch shadowMsg := msg.(type) {
 	case tea.KeyMsg:
 		switch shadowMsg.String() {
@@ -207,28 +247,42 @@ func (m *ModelGithubTrigger) View() string {
 			*keyWidth = *valueWidth / 2
 		}
 		m.tableTrigger.SetColumns(newTableColumns)
-		m.tableTrigger.SetHeight(m.skeleton.GetTerminalHeight() - 17)
+		m.tableTrigger.SetHeight(m.skeleton.GetTerminalHeight() - 17 - inputDetailHeight - jobsSummaryHeight)
 	}
 
 	var selectedRow = m.tableTrigger.SelectedRow()
 	var selector = m.emptySelector()
 	if len(m.tableTrigger.Rows()) > 0 {
-		if selectedRow[1] == "input" {
+		if selectedRow[1] == "input" || selectedRow[1] == "number" || selectedRow[1] == "json" {
 			selector = m.inputSelector()
 		} else {
 			selector = m.optionSelector()
 		}
 	}
 
+	var content = lipgloss.JoinVertical(lipgloss.Top, baseStyle.Render(m.tableTrigger.View()), m.inputDetail())
+	var jobsSummary string
+	if m.workflowContent != nil {
+		ctx := workflow.NewDispatchContext(m.selectedRepository.RepositoryName, m.selectedRepository.Ref(),
+			m.workflowContent.InputValues())
+		jobsSummary = m.jobsPreview.Summary(ctx, m.Keys.JobsPreview.Help().Key)
+		if m.jobsPreview.Visible() {
+			// The preview takes the place of the table and the input detail
+			content = m.jobsPreview.View(ctx, lipgloss.Height(content))
+		}
+	}
+
 	return lipgloss.JoinVertical(lipgloss.Top,
-		baseStyle.Render(m.tableTrigger.View()), lipgloss.JoinHorizontal(lipgloss.Top, selector, m.triggerButton()),
+		content,
+		lipgloss.JoinHorizontal(lipgloss.Top, selector, m.triggerButton()),
+		jobsSummary,
 		m.status.View(), helpWindowStyle.Render(m.ViewHelp()))
 }
 
 func (m *ModelGithubTrigger) switchBetweenInputAndTable() {
 	var selectedRow = m.tableTrigger.SelectedRow()
 
-	if selectedRow[1] == "input" || selectedRow[1] == "bool" {
+	if selectedRow[1] == "input" || selectedRow[1] == "number" || selectedRow[1] == "json" || selectedRow[1] == "bool" {
 		m.textInput.Focus()
 		m.tableTrigger.Blur()
 	} else {
@@ -408,13 +462,44 @@ func (m *ModelGithubTrigger) syncWorkflowContent(ctx context.Context) {
 	}
 
 	m.workflowContent = workflowContent.Workflow
+	m.jobsPreview.SetJobs(workflowContent.Jobs)
 
+	m.jsonKeyPrompt = false
+	m.fillTableWithWorkflowContent()
+	m.tableTrigger.SetCursor(0)
+	m.optionCursor = 0
+	m.optionValues = nil
+	m.triggerFocused = false
+	m.tableTrigger.Focus()
+
+	// reset input value
+	m.textInput.SetCursor(0)
+	m.textInput.SetValue("")
+	m.textInput.Placeholder = ""
+
+	m.tableReady = true
+	m.isTriggerable = true
+
+	if len(workflowContent.Workflow.KeyVals) == 0 &&
+		len(workflowContent.Workflow.Choices) == 0 &&
+		len(workflowContent.Workflow.Inputs) == 0 {
+		m.fillTableWithEmptyMessage()
+		m.status.SetDefaultMessage(fmt.Sprintf("[%s@%s] Workflow doesn't contain options but still triggerable",
+			m.selectedRepository.RepositoryName, m.selectedRepository.BranchName))
+	} else {
+		m.status.SetSuccessMessage(fmt.Sprintf("[%s@%s] Workflow contents fetched.",
+			m.selectedRepository.RepositoryName, m.selectedRepository.BranchName))
+	}
+}
+
+// fillTableWithWorkflowContent fills the table with the inputs of the workflow, sorted by their IDs
+func (m *ModelGithubTrigger) fillTableWithWorkflowContent() {
 	var tableRowsTrigger []table.Row
 	for _, keyVal := range m.workflowContent.KeyVals {
 		tableRowsTrigger = append(tableRowsTrigger, table.Row{
 			fmt.Sprintf("%d", keyVal.ID),
-			"input", // json type
-			keyVal.Key,
+			"json",
+			inputKey(keyVal.Key, keyVal.Required),
 			keyVal.Default,
 			keyVal.Value,
 		})
@@ -424,17 +509,22 @@ func (m *ModelGithubTrigger) syncWorkflowContent(ctx context.Context) {
 		tableRowsTrigger = append(tableRowsTrigger, table.Row{
 			fmt.Sprintf("%d", choice.ID),
 			"choice",
-			choice.Key,
+			inputKey(choice.Key, choice.Required),
 			choice.Default,
 			choice.Value,
 		})
 	}
 
 	for _, input := range m.workflowContent.Inputs {
+		var inputType = "input"
+		if input.Type == "number" {
+			inputType = "number"
+		}
+
 		tableRowsTrigger = append(tableRowsTrigger, table.Row{
 			fmt.Sprintf("%d", input.ID),
-			"input",
-			input.Key,
+			inputType,
+			inputKey(input.Key, input.Required),
 			input.Default,
 			input.Value,
 		})
@@ -444,38 +534,100 @@ func (m *ModelGithubTrigger) syncWorkflowContent(ctx context.Context) {
 		tableRowsTrigger = append(tableRowsTrigger, table.Row{
 			fmt.Sprintf("%d", boolean.ID),
 			"bool",
-			boolean.Key,
+			inputKey(boolean.Key, boolean.Required),
 			boolean.Default,
 			boolean.Value,
 		})
 	}
 
 	m.tableTrigger.SetRows(tableRowsTrigger)
-	m.sortTableItemsByName()
-	m.tableTrigger.SetCursor(0)
-	m.optionCursor = 0
-	m.optionValues = nil
-	m.triggerFocused = false
-	m.tableTrigger.Focus()
+	m.sortTableItemsByID()
+}
 
-	// reset input value
-	m.textInput.SetCursor(0)
-	m.textInput.SetValue("")
-	m.textInput.Placeholder = ""
+// handleJSONKeyMsg adds and removes keys and items of the JSON input of the selected row, and reads the name of an
+// added key. It reports whether the key is handled.
+func (m *ModelGithubTrigger) handleJSONKeyMsg(msg tea.KeyMsg) bool {
+	selectedRow := m.tableTrigger.SelectedRow()
+	if m.workflowContent == nil || m.triggerFocused || len(selectedRow) == 0 || selectedRow[1] != "json" {
+		return false
+	}
+	id, _ := strconv.Atoi(selectedRow[0])
+
+	if m.jsonKeyPrompt {
+		switch msg.String() {
+		case "enter":
+			m.jsonKeyPrompt = false
+			name := strings.TrimSpace(m.textInput.Value())
+			if err := m.workflowContent.AddJSONChild(id, name); err != nil {
+				m.status.SetError(err)
+				m.status.SetErrorMessage(fmt.Sprintf("Key %s cannot be added", name))
+			} else {
+				m.status.SetSuccessMessage(fmt.Sprintf("Key %s added.", name))
+			}
+			m.refreshJSONRows()
+		case "esc":
+			m.jsonKeyPrompt = false
+			m.status.Reset()
+			m.refreshJSONRows()
+		case "up", "down", "tab":
+			// The row and the focus stay while the name is typed
+			return true
+		default:
+			return false
+		}
+		return true
+	}
 
-	m.tableReady = true
-	m.isTriggerable = true
+	switch {
+	case key.Matches(msg, m.Keys.AddJSON):
+		kind, ok := m.workflowContent.JSONContainer(id)
+		if !ok {
+			m.status.SetErrorMessage("Keys cannot be added to this input")
+			return true
+		}
+		if kind == py.JSONObject {
+			m.jsonKeyPrompt = true
+			m.textInput.SetValue("")
+			m.textInput.Placeholder = "name of the new key"
+			m.status.SetDefaultMessage("Type the name of the new key, enter adds it and esc cancels.")
+			return true
+		}
+		if err := m.workflowContent.AddJSONChild(id, ""); err != nil {
+			m.status.SetError(err)
+			m.status.SetErrorMessage("Item cannot be added")
+		} else {
+			m.status.SetSuccessMessage("Item added.")
+		}
+		m.refreshJSONRows()
+		return true
+	case key.Matches(msg, m.Keys.RemoveJSON):
+		if err := m.workflowContent.RemoveJSONNode(id); err != nil {
+			m.status.SetError(err)
+			m.status.SetErrorMessage(fmt.Sprintf("%s cannot be removed", selectedRow[2]))
+		} else {
+			m.status.SetSuccessMessage(fmt.Sprintf("%s removed.", selectedRow[2]))
+		}
+		m.refreshJSONRows()
+		return true
+	}
+	return false
+}
 
-	if len(workflowContent.Workflow.KeyVals) == 0 &&
-		len(workflowContent.Workflow.Choices) == 0 &&
-		len(workflowContent.Workflow.Inputs) == 0 {
-		m.fillTableWithEmptyMessage()
-		m.status.SetDefaultMessage(fmt.Sprintf("[%s@%s] Workflow doesn't contain options but still triggerable",
-			m.selectedRepository.RepositoryName, m.selectedRepository.BranchName))
-	} else {
-		m.status.SetSuccessMessage(fmt.Sprintf("[%s@%s] Workflow contents fetched.",
-			m.selectedRepository.RepositoryName, m.selectedRepository.BranchName))
+// refreshJSONRows fills the table again after keys or items of a JSON input are added or removed, the cursor stays
+// on the same row
+func (m *ModelGithubTrigger) refreshJSONRows() {
+	cursor := m.tableTrigger.Cursor()
+	m.fillTableWithWorkflowContent()
+	m.tableTrigger.SetCursor(min(cursor, len(m.tableTrigger.Rows())-1))
+	m.switchBetweenInputAndTable()
+}
+
+// inputKey marks required inputs in the table
+func inputKey(key string, required bool) string {
+	if required {
+		return key + " *"
 	}
+	return key
 }
 
 func (m *ModelGithubTrigger) fillTableWithEmptyMessage() {
@@ -570,6 +722,12 @@ func (m *ModelGithubTrigger) triggerWorkflow() {
 		return
 	}
 
+	if err := m.workflowContent.Validate(); err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage("Workflow inputs are invalid")
+		return
+	}
+
 	content, err := m.workflowContent.ToJson()
 	if err != nil {
 		m.status.SetError(err)
@@ -626,9 +784,39 @@ func (m *ModelGithubTrigger) inputSelector() string {
 		Padding(0, 1).
 		Width(m.skeleton.GetTerminalWidth() - 17).MarginLeft(1)
 
+	// Highlight values which don't match the type of the input
+	if err := m.validateSelectedInput(); err != nil {
+		windowStyle = windowStyle.BorderForeground(lipgloss.Color("9"))
+		return windowStyle.Render(lipgloss.JoinHorizontal(lipgloss.Left, m.textInput.View(),
+			lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render(fmt.Sprintf(" (%v)", err))))
+	}
+
 	return windowStyle.Render(m.textInput.View())
 }
 
+func (m *ModelGithubTrigger) validateSelectedInput() error {
+	var selectedRow = m.tableTrigger.SelectedRow()
+	var value = m.textInput.Value()
+	if len(selectedRow) == 0 || value == "" || m.workflowContent == nil {
+		return nil
+	}
+
+	switch selectedRow[1] {
+	case "number":
+		if !workflow.IsNumber(value) {
+			return errors.New("not a number")
+		}
+	case "json":
+		for _, keyVal := range m.workflowContent.KeyVals {
+			if fmt.Sprintf("%d", keyVal.ID) == selectedRow[0] {
+				return keyVal.CheckValue(value)
+			}
+		}
+	}
+
+	return nil
+}
+
 // optionSelector renders the options list
 // TODO: Make this dynamic limited&sized.
 func (m *ModelGithubTrigger) optionSelector() string {
@@ -655,10 +843,101 @@ func (m *ModelGithubTrigger) optionSelector() string {
 	return windowStyle.Render(lipgloss.JoinHorizontal(lipgloss.Left, processedValues...))
 }
 
-func (m *ModelGithubTrigger) sortTableItemsByName() {
+// sortTableItemsByID sorts the rows by their IDs, which follow the declaration order in the workflow file
+func (m *ModelGithubTrigger) sortTableItemsByID() {
 	rows := m.tableTrigger.Rows()
 	slices.SortFunc(rows, func(a, b table.Row) int {
-		return strings.Compare(a[2], b[2])
+		idA, _ := strconv.Atoi(a[0])
+		idB, _ := strconv.Atoi(b[0])
+		return idA - idB
 	})
 	m.tableTrigger.SetRows(rows)
 }
+
+// inputDetail renders the description, declared type and required flag of the selected input
+func (m *ModelGithubTrigger) inputDetail() string {
+	width := m.skeleton.GetTerminalWidth() - 6
+
+	windowStyle := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("#3b698f")).
+		Padding(0, 1).
+		Width(width).MarginLeft(1)
+
+	// Keep the pane at a fixed height, long descriptions are wrapped and truncated
+	contentStyle := lipgloss.NewStyle().
+		Width(width - 2).
+		Height(inputDetailHeight - 2).
+		MaxHeight(inputDetailHeight - 2)
+
+	detail, ok := m.selectedInputDetail()
+	if !ok {
+		return windowStyle.Render(contentStyle.Render(""))
+	}
+
+	keyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("120"))
+	typeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("140"))
+	requiredStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	descriptionStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("250"))
+
+	header := keyStyle.Render(detail.key) + " " + typeStyle.Render(fmt.Sprintf("(%s)", detail.inputType))
+	if detail.required {
+		header += " " + requiredStyle.Render("required")
+	}
+
+	description := detail.description
+	if description == "" {
+		description = "No description provided"
+	}
+
+	return windowStyle.Render(contentStyle.Render(
+		lipgloss.JoinVertical(lipgloss.Left, header, descriptionStyle.Render(description))))
+}
+
+type triggerInputDetail struct {
+	key         string
+	description string
+	inputType   string
+	required    bool
+}
+
+func (m *ModelGithubTrigger) selectedInputDetail() (triggerInputDetail, bool) {
+	if m.workflowContent == nil || len(m.tableTrigger.Rows()) == 0 {
+		return triggerInputDetail{}, false
+	}
+
+	selectedRow := m.tableTrigger.SelectedRow()
+	if len(selectedRow) == 0 {
+		return triggerInputDetail{}, false
+	}
+
+	for _, keyVal := range m.workflowContent.KeyVals {
+		if fmt.Sprintf("%d", keyVal.ID) == selectedRow[0] {
+			key := keyVal.Key
+			if keyVal.Parent != nil {
+				key = fmt.Sprintf("%s.%s", *keyVal.Parent, keyVal.Key)
+			}
+			return triggerInputDetail{key, keyVal.Description, fmt.Sprintf("%s, json %s", keyVal.Type, keyVal.JSONType), keyVal.Required}, true
+		}
+	}
+
+	for _, choice := range m.workflowContent.Choices {
+		if fmt.Sprintf("%d", choice.ID) == selectedRow[0] {
+			return triggerInputDetail{choice.Key, choice.Description, choice.Type, choice.Required}, true
+		}
+	}
+
+	for _, input := range m.workflowContent.Inputs {
+		if fmt.Sprintf("%d", input.ID) == selectedRow[0] {
+			return triggerInputDetail{input.Key, input.Description, input.Type, input.Required}, true
+		}
+	}
+
+	for _, boolean := range m.workflowContent.Boolean {
+		if fmt.Sprintf("%d", boolean.ID) == selectedRow[0] {
+			return triggerInputDetail{boolean.Key, boolean.Description, boolean.Type, boolean.Required}, true
+		}
+	}
+
+	return triggerInputDetail{}, false
+}
@@ -0,0 +1,294 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gu "github.com/termkit/gama/internal/github/usecase"
+	"github.com/termkit/gama/pkg/browser"
+	"github.com/termkit/skeleton"
+)
+
+// -----------------------------------------------------------------------------
+// Model Definition
+// -----------------------------------------------------------------------------
+
+// ModelGithubUsage lists the billable time of the workflows of the selected repository in the current billing cycle
+type ModelGithubUsage struct {
+	// Core dependencies
+	skeleton *skeleton.Skeleton
+	github   gu.UseCase
+
+	// UI Components
+	Help            help.Model
+	keys            githubUsageKeyMap
+	tableUsage      table.Model
+	status          *ModelStatus
+	modelTabOptions *ModelTabOptions
+
+	// Table state
+	tableStyle     lipgloss.Style
+	usages         []gu.WorkflowUsage
+	lastRepository string
+	sort           tableSort
+
+	// Workflow state
+	selectedUsage gu.WorkflowUsage
+
+	// Context management
+	syncUsageContext context.Context
+	cancelSyncUsage  context.CancelFunc
+
+	// Shared state
+	selectedRepository *SelectedRepository
+}
+
+// -----------------------------------------------------------------------------
+// Constructor & Initialization
+// -----------------------------------------------------------------------------
+
+func SetupModelGithubUsage(s *skeleton.Skeleton, githubUseCase gu.UseCase) *ModelGithubUsage {
+	modelStatus := SetupModelStatus(s)
+	m := &ModelGithubUsage{
+		// Initialize core dependencies
+		skeleton: s,
+		github:   githubUseCase,
+
+		// Initialize UI components
+		Help:            help.New(),
+		keys:            githubUsageKeys,
+		status:          modelStatus,
+		modelTabOptions: NewOptions(s, modelStatus),
+
+		// Initialize state
+		selectedRepository: NewSelectedRepository(),
+		tableStyle:         setupTableStyle(),
+		sort:               newTableSort(),
+		syncUsageContext:   context.Background(),
+		cancelSyncUsage:    func() {},
+	}
+
+	m.tableUsage = setupWorkflowHistoryTable(fitColumns(usageColumns, m.sort, 0))
+
+	return m
+}
+
+// -----------------------------------------------------------------------------
+// Bubbletea Model Implementation
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubUsage) Init() tea.Cmd {
+	m.setupOptions()
+	return tea.Batch(m.modelTabOptions.Init())
+}
+
+func (m *ModelGithubUsage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.handleRepositoryChange()
+
+	cursor := m.tableUsage.Cursor()
+	if cursor >= 0 && cursor < len(m.usages) {
+		m.selectedUsage = m.usages[cursor]
+	} else {
+		m.selectedUsage = gu.WorkflowUsage{}
+	}
+
+	var cmds []tea.Cmd
+	var cmd tea.Cmd
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		m.handleKeyMsg(keyMsg)
+	}
+
+	m.tableUsage, cmd = m.tableUsage.Update(msg)
+	cmds = append(cmds, cmd)
+
+	m.modelTabOptions, cmd = m.modelTabOptions.Update(msg)
+	cmds = append(cmds, cmd)
+
+	return m, tea.Batch(cmds...)
+}
+
+func (m *ModelGithubUsage) View() string {
+	helpStyle := WindowStyleHelp.Width(m.skeleton.GetTerminalWidth() - 4)
+
+	m.updateTableDimensions()
+	return lipgloss.JoinVertical(lipgloss.Top,
+		m.tableStyle.Render(m.tableUsage.View()),
+		m.modelTabOptions.View(),
+		m.status.View(),
+		helpStyle.Render(m.ViewHelp()),
+	)
+}
+
+// -----------------------------------------------------------------------------
+// Event Handlers
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubUsage) handleKeyMsg(msg tea.KeyMsg) {
+	switch {
+	case key.Matches(msg, m.keys.Refresh):
+		go m.syncUsage(m.syncUsageContext)
+	case key.Matches(msg, m.keys.SortBy):
+		m.sort.next(len(usageColumns))
+		m.resortTable()
+	case key.Matches(msg, m.keys.SortOrder):
+		m.sort.reverse()
+		m.resortTable()
+	}
+}
+
+// resortTable sorts the table again and keeps the cursor on the selected workflow
+func (m *ModelGithubUsage) resortTable() {
+	m.updateTableDimensions()
+	m.updateTable()
+	for i, usage := range m.usages {
+		if usage.ID == m.selectedUsage.ID {
+			m.tableUsage.SetCursor(i)
+			break
+		}
+	}
+	m.status.SetDefaultMessage(fmt.Sprintf("[%s] %s", m.selectedRepository.RepositoryName,
+		m.sort.describe(m.tableUsage.Columns())))
+}
+
+// -----------------------------------------------------------------------------
+// Repository Change Handling
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubUsage) handleRepositoryChange() {
+	if m.lastRepository == m.selectedRepository.RepositoryName {
+		return
+	}
+
+	m.cancelSyncUsage()
+	m.lastRepository = m.selectedRepository.RepositoryName
+	m.syncUsageContext, m.cancelSyncUsage = context.WithCancel(context.Background())
+
+	go m.syncUsage(m.syncUsageContext)
+}
+
+// -----------------------------------------------------------------------------
+// Usage Sync
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubUsage) syncUsage(ctx context.Context) {
+	defer m.skeleton.TriggerUpdate()
+
+	if m.selectedRepository.RepositoryName == "" {
+		m.status.SetDefaultMessage("Select a repository in the Repository tab to see its usage")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	m.status.Reset()
+	m.status.SetProgressMessage(fmt.Sprintf("[%s] Fetching usage of the workflows...", m.selectedRepository.RepositoryName))
+	m.modelTabOptions.SetStatus(StatusWait)
+	m.tableUsage.SetRows([]table.Row{})
+	m.usages = nil
+
+	usage, err := m.github.GetRepositoryUsage(ctx, gu.GetRepositoryUsageInput{
+		Repository: m.selectedRepository.RepositoryName,
+	})
+	if err != nil {
+		m.modelTabOptions.SetStatus(StatusNone)
+		switch {
+		case errors.Is(err, context.Canceled):
+			m.status.SetDefaultMessage("Usage fetch cancelled")
+		case errors.Is(err, context.DeadlineExceeded):
+			m.status.SetErrorMessage("Usage fetch timed out")
+		default:
+			m.status.SetError(err)
+			m.status.SetErrorMessage(fmt.Sprintf("Failed to fetch usage: %v", err))
+		}
+		return
+	}
+
+	m.usages = usage.Workflows
+	m.updateTable()
+	m.tableUsage.SetCursor(0)
+	m.modelTabOptions.SetStatus(StatusIdle)
+
+	message := fmt.Sprintf("[%s] %d minutes used by %d workflows in the current billing cycle.",
+		m.selectedRepository.RepositoryName, usage.Minutes, len(usage.Workflows))
+	if len(usage.Failures) > 0 {
+		var failed []string
+		for _, failure := range usage.Failures {
+			failed = append(failed, failure.Path)
+		}
+		m.status.SetError(usage.Failures[0].Err)
+		m.status.SetErrorMessage(fmt.Sprintf("%s Usage of %s cannot be fetched", message, strings.Join(failed, ", ")))
+		return
+	}
+	m.status.SetSuccessMessage(message)
+}
+
+func (m *ModelGithubUsage) updateTable() {
+	rows := columnRows(usageColumns, m.usages)
+	sortTable(m.sort, rows, m.usages)
+	m.tableUsage.SetRows(rows)
+}
+
+// -----------------------------------------------------------------------------
+// UI Rendering
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubUsage) updateTableDimensions() {
+	const (
+		minTableWidth = 80 // Minimum width to maintain readability
+		tablePadding  = 18 // Account for borders and margins
+	)
+
+	termWidth := m.skeleton.GetTerminalWidth()
+	termHeight := m.skeleton.GetTerminalHeight()
+
+	if termWidth <= minTableWidth {
+		return // Prevent table from becoming too narrow
+	}
+
+	// Extra width is shared by the workflow name and the file
+	m.tableUsage.SetColumns(fitColumns(usageColumns, m.sort, termWidth-tablePadding))
+
+	if maxHeight := termHeight - 17; maxHeight > 0 {
+		m.tableUsage.SetHeight(maxHeight)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Option Management
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubUsage) setupOptions() {
+	m.modelTabOptions.AddOption("Browse", m.openInBrowser)
+}
+
+func (m *ModelGithubUsage) openInBrowser() {
+	if m.selectedUsage.ID == 0 {
+		m.status.SetErrorMessage("No workflow selected")
+		return
+	}
+
+	m.status.SetProgressMessage("Opening in browser...")
+
+	workflowURL := fmt.Sprintf("https://github.com/%s/actions/workflows/%s",
+		m.selectedRepository.RepositoryName,
+		url.PathEscape(path.Base(m.selectedUsage.Path)))
+
+	if err := browser.OpenInBrowser(workflowURL); err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage("Failed to open in browser")
+		return
+	}
+	m.status.SetSuccessMessage("Opened in browser")
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
+	"slices"
 	"sort"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
@@ -13,6 +16,8 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	gu "github.com/termkit/gama/internal/github/usecase"
+	"github.com/termkit/gama/pkg/browser"
+	"github.com/termkit/gama/pkg/fuzzy"
 	"github.com/termkit/skeleton"
 )
 
@@ -31,6 +36,8 @@ type ModelGithubWorkflow struct {
 	tableTriggerableWorkflow table.Model
 	status                   *ModelStatus
 	textInput                textinput.Model
+	modelTabOptions          *ModelTabOptions
+	fileViewer               *ModelWorkflowFile
 
 	// Table state
 	tableReady bool
@@ -46,6 +53,21 @@ type ModelGithubWorkflow struct {
 	hasWorkflows           bool
 	lastSelectedRepository string // Track last repository for state persistence
 
+	// Workflows of the repository, non-dispatchable ones are listed only when showAllWorkflows is set
+	workflows        []gu.WorkflowWithTriggers
+	showAllWorkflows bool
+	sort             tableSort // workflows are sorted by name until a column is picked
+
+	// Ref selection, workflows can be triggered on branches, tags or any other git ref
+	refMode  refMode
+	branches []gu.GithubBranch
+	tags     []string
+
+	// Fuzzy matched refs shown under the ref input
+	suggestions      []fuzzy.Match
+	suggestionCursor int
+	lastRefInput     string
+
 	// State management
 	state struct {
 		Ready      bool
@@ -59,12 +81,33 @@ type ModelGithubWorkflow struct {
 	}
 }
 
+// refMode is the kind of git ref typed into the ref input
+type refMode int
+
+const (
+	refModeBranch refMode = iota
+	refModeTag
+	refModeFree
+)
+
+func (r refMode) String() string {
+	switch r {
+	case refModeTag:
+		return "tag"
+	case refModeFree:
+		return "ref"
+	default:
+		return "branch"
+	}
+}
+
 // -----------------------------------------------------------------------------
 // Constructor & Initialization
 // -----------------------------------------------------------------------------
 
 func SetupModelGithubWorkflow(s *skeleton.Skeleton, githubUseCase gu.UseCase) *ModelGithubWorkflow {
 	modelStatus := SetupModelStatus(s)
+	tabOptions := NewOptions(s, modelStatus)
 
 	m := &ModelGithubWorkflow{
 		// Initialize core dependencies
@@ -72,15 +115,18 @@ func SetupModelGithubWorkflow(s *skeleton.Skeleton, githubUseCase gu.UseCase) *M
 		github:   githubUseCase,
 
 		// Initialize UI components
-		help:      help.New(),
-		keys:      githubWorkflowKeys,
-		status:    modelStatus,
-		textInput: setupBranchInput(),
+		help:            help.New(),
+		keys:            githubWorkflowKeys,
+		status:          modelStatus,
+		textInput:       setupBranchInput(),
+		modelTabOptions: tabOptions,
+		fileViewer:      SetupModelWorkflowFile(s),
 
 		// Initialize state
 		selectedRepository:              NewSelectedRepository(),
 		syncTriggerableWorkflowsContext: context.Background(),
 		cancelSyncTriggerableWorkflows:  func() {},
+		sort:                            newTableSort(),
 	}
 
 	// Setup table and blur initially
@@ -96,7 +142,8 @@ func setupBranchInput() textinput.Model {
 	ti.Focus()
 	ti.CharLimit = 128
 	ti.Placeholder = "Type to switch branch"
-	ti.ShowSuggestions = true
+	ti.Prompt = fmt.Sprintf("%s > ", refModeBranch)
+	ti.ShowSuggestions = false // suggestions are fuzzy matched and rendered by the model
 	return ti
 }
 
@@ -139,6 +186,8 @@ func setupWorkflowTable() table.Model {
 // -----------------------------------------------------------------------------
 
 func (m *ModelGithubWorkflow) Init() tea.Cmd {
+	m.setupOptions()
+
 	// Check initial state
 	if m.lastSelectedRepository == m.selectedRepository.RepositoryName && !m.hasWorkflows {
 		m.skeleton.LockTab("trigger")
@@ -155,9 +204,58 @@ func (m *ModelGithubWorkflow) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 	var cmd tea.Cmd
 
+	// The file viewer takes the keys while it is open
+	if m.fileViewer.Visible() {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			switch {
+			case key.Matches(keyMsg, m.keys.CloseFile):
+				m.closeWorkflowFile()
+				return m, nil
+			case key.Matches(keyMsg, m.keys.ToggleGraph):
+				if !m.fileViewer.ToggleGraph() {
+					m.status.SetErrorMessage("Jobs graph cannot be drawn, the workflow cannot be parsed")
+				}
+				return m, nil
+			}
+		}
+		m.fileViewer, cmd = m.fileViewer.Update(msg)
+		return m, cmd
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if key.Matches(keyMsg, m.keys.ToggleAllWorkflows) {
+			m.toggleAllWorkflows()
+			msg = tea.KeyMsg{Type: tea.KeyNull}
+		}
+
+		switch {
+		case key.Matches(keyMsg, m.keys.SortBy):
+			m.sort.next(len(m.workflowColumns()))
+			m.resortWorkflowTable()
+			return m, nil
+		case key.Matches(keyMsg, m.keys.SortOrder):
+			m.sort.reverse()
+			m.resortWorkflowTable()
+			return m, nil
+		}
+
+		if m.handleSuggestionKeys(keyMsg) {
+			// delete msg key to prevent moving cursor
+			msg = tea.KeyMsg{Type: tea.KeyNull}
+		}
+
+		// The ref input takes the number keys while it is focused, the options are selected with alt
+		if optionMsg, ok := m.optionKeyMsg(keyMsg); ok {
+			m.modelTabOptions, cmd = m.modelTabOptions.Update(optionMsg)
+			cmds = append(cmds, cmd)
+			msg = tea.KeyMsg{Type: tea.KeyNull}
+		}
+	}
+
 	// Update text input and handle branch selection
 	m.textInput, cmd = m.textInput.Update(msg)
 	cmds = append(cmds, cmd)
+	m.updateSuggestions()
 	m.handleBranchSelection()
 
 	// Update table and handle workflow selection
@@ -169,9 +267,20 @@ func (m *ModelGithubWorkflow) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m *ModelGithubWorkflow) View() string {
+	if m.fileViewer.Visible() {
+		// The file viewer takes the place of the table, the ref input and the options
+		return lipgloss.JoinVertical(lipgloss.Top,
+			m.fileViewer.View(m.skeleton.GetTerminalHeight()-10),
+			m.status.View(),
+			m.renderHelp(),
+		)
+	}
+
 	return lipgloss.JoinVertical(lipgloss.Top,
 		m.renderTable(),
 		m.renderBranchInput(),
+		m.renderSuggestions(),
+		m.modelTabOptions.View(),
 		m.status.View(),
 		m.renderHelp(),
 	)
@@ -186,6 +295,8 @@ func (m *ModelGithubWorkflow) handleRepositoryChange() {
 		m.state.Ready = false
 		m.state.Repository.Current = m.selectedRepository.RepositoryName
 		m.state.Repository.Branch = m.selectedRepository.BranchName
+		m.tags = nil // the tags of the previous repository are not suggested while the tags are fetched
+		m.closeWorkflowFile()
 		m.syncWorkflows()
 	} else if !m.state.Repository.HasFlows {
 		m.skeleton.LockTab("trigger")
@@ -202,11 +313,18 @@ func (m *ModelGithubWorkflow) handleBranchSelection() {
 	// Set branch
 	if selectedBranch == "" {
 		m.selectedRepository.BranchName = m.state.Repository.Branch
+		m.selectedRepository.BranchIsTag = false
 	} else if m.isBranchValid(selectedBranch) {
 		m.selectedRepository.BranchName = selectedBranch
+		m.selectedRepository.BranchIsTag = m.refMode == refModeTag
 	} else {
-		m.status.SetErrorMessage(fmt.Sprintf("Branch %s does not exist", selectedBranch))
-		m.skeleton.LockTabsToTheRight()
+		if m.refMode == refModeFree {
+			m.status.SetErrorMessage(fmt.Sprintf("Ref %s is not a valid git ref", selectedBranch))
+		} else {
+			m.status.SetErrorMessage(fmt.Sprintf("%s %s does not exist", m.refMode, selectedBranch))
+		}
+		// Only the trigger needs the ref, repository_dispatch events are sent to the default branch
+		m.skeleton.LockTab("trigger")
 		return
 	}
 
@@ -214,15 +332,107 @@ func (m *ModelGithubWorkflow) handleBranchSelection() {
 	m.updateTabState()
 }
 
+// isBranchValid validates the ref against the selected ref mode
 func (m *ModelGithubWorkflow) isBranchValid(branch string) bool {
-	for _, suggestion := range m.textInput.AvailableSuggestions() {
-		if suggestion == branch {
-			return true
+	if m.refMode == refModeFree {
+		return isValidRefName(branch)
+	}
+	return slices.Contains(m.refCandidates(), branch)
+}
+
+// refCandidates returns the refs of the selected ref mode
+func (m *ModelGithubWorkflow) refCandidates() []string {
+	switch m.refMode {
+	case refModeTag:
+		return m.tags
+	case refModeFree:
+		return nil
+	default:
+		names := make([]string, len(m.branches))
+		for i, branch := range m.branches {
+			names[i] = branch.Name
+		}
+		return names
+	}
+}
+
+// isValidRefName checks the ref against the rules of git check-ref-format
+func isValidRefName(ref string) bool {
+	if ref == "" || ref == "@" || strings.HasPrefix(ref, "/") || strings.HasSuffix(ref, "/") ||
+		strings.HasSuffix(ref, ".") || strings.HasSuffix(ref, ".lock") ||
+		strings.Contains(ref, "..") || strings.Contains(ref, "//") || strings.Contains(ref, "@{") {
+		return false
+	}
+
+	for _, r := range ref {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(" ~^:?*[\\\"", r) {
+			return false
 		}
 	}
+
+	return true
+}
+
+// switchRefMode cycles between branch, tag and free-form ref inputs
+func (m *ModelGithubWorkflow) switchRefMode() {
+	m.refMode = (m.refMode + 1) % 3
+	m.textInput.Reset()
+	m.textInput.Prompt = fmt.Sprintf("%s > ", m.refMode)
+	m.refreshSuggestions()
+}
+
+// -----------------------------------------------------------------------------
+// Ref Suggestions
+// -----------------------------------------------------------------------------
+
+// handleSuggestionKeys moves between and accepts suggestions, it reports whether the key is consumed
+func (m *ModelGithubWorkflow) handleSuggestionKeys(msg tea.KeyMsg) bool {
+	switch {
+	case key.Matches(msg, m.keys.SwitchRefMode):
+		m.switchRefMode()
+		return true
+	case len(m.suggestions) == 0:
+		return false
+	case key.Matches(msg, m.keys.NextSuggestion):
+		m.suggestionCursor = min(m.suggestionCursor+1, len(m.suggestions)-1)
+		return true
+	case key.Matches(msg, m.keys.PrevSuggestion):
+		m.suggestionCursor = max(m.suggestionCursor-1, 0)
+		return true
+	case key.Matches(msg, m.keys.AcceptSuggestion):
+		m.textInput.SetValue(m.suggestions[m.suggestionCursor].Value)
+		m.textInput.CursorEnd()
+		return true
+	}
 	return false
 }
 
+// updateSuggestions refreshes the suggestions when the typed ref changes
+func (m *ModelGithubWorkflow) updateSuggestions() {
+	if m.textInput.Value() == m.lastRefInput {
+		return
+	}
+	m.refreshSuggestions()
+}
+
+func (m *ModelGithubWorkflow) refreshSuggestions() {
+	value := m.textInput.Value()
+	m.lastRefInput = value
+	m.suggestionCursor = 0
+
+	if value == "" || m.refMode == refModeFree {
+		m.suggestions = nil
+		return
+	}
+
+	m.suggestions = fuzzy.Find(value, m.refCandidates())
+
+	// Nothing to suggest if the typed ref is the only match
+	if len(m.suggestions) == 1 && m.suggestions[0].Value == value {
+		m.suggestions = nil
+	}
+}
+
 // -----------------------------------------------------------------------------
 // Workflow Sync & Management
 // -----------------------------------------------------------------------------
@@ -243,6 +453,7 @@ func (m *ModelGithubWorkflow) syncWorkflows() {
 		}()
 
 		m.syncBranches(ctx)
+		m.syncTags(ctx)
 		m.syncTriggerableWorkflows(ctx)
 	}()
 }
@@ -260,16 +471,16 @@ func (m *ModelGithubWorkflow) syncTriggerableWorkflows(ctx context.Context) {
 }
 
 func (m *ModelGithubWorkflow) initializeSyncState() {
+	m.modelTabOptions.SetStatus(StatusWait)
 	m.status.Reset()
 	m.status.SetProgressMessage(fmt.Sprintf("[%s@%s] Fetching triggerable workflows...",
 		m.selectedRepository.RepositoryName, m.selectedRepository.BranchName))
 	m.tableTriggerableWorkflow.SetRows([]table.Row{})
 }
 
-func (m *ModelGithubWorkflow) fetchTriggerableWorkflows(ctx context.Context) (*gu.GetTriggerableWorkflowsOutput, error) {
-	workflows, err := m.github.GetTriggerableWorkflows(ctx, gu.GetTriggerableWorkflowsInput{
+func (m *ModelGithubWorkflow) fetchTriggerableWorkflows(ctx context.Context) (*gu.GetWorkflowsWithTriggersOutput, error) {
+	workflows, err := m.github.GetWorkflowsWithTriggers(ctx, gu.GetWorkflowsWithTriggersInput{
 		Repository: m.selectedRepository.RepositoryName,
-		Branch:     m.selectedRepository.BranchName,
 	})
 
 	if err != nil {
@@ -283,26 +494,79 @@ func (m *ModelGithubWorkflow) fetchTriggerableWorkflows(ctx context.Context) (*g
 	return workflows, nil
 }
 
-func (m *ModelGithubWorkflow) processWorkflows(workflows *gu.GetTriggerableWorkflowsOutput) {
-	m.state.Repository.HasFlows = len(workflows.TriggerableWorkflows) > 0
+func (m *ModelGithubWorkflow) processWorkflows(workflows *gu.GetWorkflowsWithTriggersOutput) {
+	m.workflows = workflows.Workflows
+	m.state.Repository.HasFlows = slices.ContainsFunc(m.workflows, func(workflow gu.WorkflowWithTriggers) bool {
+		return workflow.Dispatchable
+	})
 	m.state.Repository.Current = m.selectedRepository.RepositoryName
 	m.state.Ready = true
 
-	if !m.state.Repository.HasFlows {
+	if m.showWorkflows() {
+		m.finalizeUpdate()
+	}
+}
+
+// showWorkflows fills the table with the workflows of the selected view, it reports whether there is any workflow to show
+func (m *ModelGithubWorkflow) showWorkflows() bool {
+	visibleWorkflows := m.visibleWorkflows()
+	if len(visibleWorkflows) == 0 {
 		m.handleEmptyWorkflows()
-		return
+		return false
 	}
 
-	m.updateWorkflowTable(workflows.TriggerableWorkflows)
+	m.updateWorkflowTable(visibleWorkflows)
+	m.tableReady = true
 	m.updateTabState()
-	m.finalizeUpdate()
+	m.modelTabOptions.SetStatus(StatusIdle)
 
-	// Focus components when workflows exist
+	// Focus components when workflows exist, refs can be selected only for dispatchable workflows
 	m.tableTriggerableWorkflow.Focus()
-	m.textInput.Focus()
+	if m.state.Repository.HasFlows {
+		m.textInput.Focus()
+	} else {
+		m.textInput.Blur()
+	}
+	return true
+}
+
+// visibleWorkflows returns the workflows of the selected view
+func (m *ModelGithubWorkflow) visibleWorkflows() []gu.WorkflowWithTriggers {
+	if m.showAllWorkflows {
+		return m.workflows
+	}
+
+	var workflows []gu.WorkflowWithTriggers
+	for _, workflow := range m.workflows {
+		if workflow.Dispatchable {
+			workflows = append(workflows, workflow)
+		}
+	}
+	return workflows
+}
+
+// toggleAllWorkflows switches between listing only dispatchable workflows and listing all workflows with their triggers
+func (m *ModelGithubWorkflow) toggleAllWorkflows() {
+	m.showAllWorkflows = !m.showAllWorkflows
+	m.sort = newTableSort()
+	m.tableTriggerableWorkflow.SetRows([]table.Row{})
+	m.tableTriggerableWorkflow.SetColumns(m.workflowColumns())
+
+	if !m.state.Ready {
+		return
+	}
+
+	if m.showWorkflows() {
+		if m.showAllWorkflows {
+			m.status.SetDefaultMessage(fmt.Sprintf("[%s] Listing all workflows.", m.selectedRepository.RepositoryName))
+		} else {
+			m.status.SetDefaultMessage(fmt.Sprintf("[%s] Listing triggerable workflows.", m.selectedRepository.RepositoryName))
+		}
+	}
 }
 
 func (m *ModelGithubWorkflow) handleEmptyWorkflows() {
+	m.modelTabOptions.SetStatus(StatusNone)
 	m.selectedRepository.WorkflowName = ""
 	m.skeleton.LockTab("trigger")
 
@@ -310,19 +574,26 @@ func (m *ModelGithubWorkflow) handleEmptyWorkflows() {
 	m.tableTriggerableWorkflow.Blur()
 	m.textInput.Blur()
 
-	m.status.SetDefaultMessage(fmt.Sprintf("[%s@%s] No triggerable workflow found.",
-		m.selectedRepository.RepositoryName, m.selectedRepository.BranchName))
+	message := "No triggerable workflow found"
+	if len(m.workflows) > 0 {
+		message = fmt.Sprintf("No triggerable workflow found, press %s to list all workflows", m.keys.ToggleAllWorkflows.Help().Key)
+	} else if m.showAllWorkflows {
+		message = "No workflow found"
+	}
+
+	m.status.SetDefaultMessage(fmt.Sprintf("[%s@%s] %s.",
+		m.selectedRepository.RepositoryName, m.selectedRepository.BranchName, message))
 
-	m.fillTableWithEmptyMessage()
+	m.fillTableWithEmptyMessage(message)
 }
 
-func (m *ModelGithubWorkflow) fillTableWithEmptyMessage() {
+func (m *ModelGithubWorkflow) fillTableWithEmptyMessage(message string) {
 	var rows []table.Row
 	for i := 0; i < 100; i++ {
-		rows = append(rows, table.Row{
-			"EMPTY",
-			"No triggerable workflow found",
-		})
+		row := make(table.Row, len(m.workflowColumns()))
+		row[0] = "EMPTY"
+		row[1] = message
+		rows = append(rows, row)
 	}
 
 	m.tableTriggerableWorkflow.SetRows(rows)
@@ -370,38 +641,79 @@ func (m *ModelGithubWorkflow) processBranches(branches *gu.GetRepositoryBranches
 		return
 	}
 
-	branchNames := make([]string, len(branches.Branches))
-	for i, branch := range branches.Branches {
-		branchNames[i] = branch.Name
-	}
-
-	m.textInput.SetSuggestions(branchNames)
+	m.branches = branches.Branches
+	m.refreshSuggestions()
 	m.status.SetSuccessMessage(fmt.Sprintf("[%s] Branches fetched.",
 		m.selectedRepository.RepositoryName))
 }
 
 func (m *ModelGithubWorkflow) handleEmptyBranches() {
+	m.branches = nil
+	m.refreshSuggestions()
 	m.selectedRepository.BranchName = ""
+	m.selectedRepository.BranchIsTag = false
 	m.status.SetDefaultMessage(fmt.Sprintf("[%s] No branches found.",
 		m.selectedRepository.RepositoryName))
 }
 
+// -----------------------------------------------------------------------------
+// Tag Sync & Management
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubWorkflow) syncTags(ctx context.Context) {
+	defer m.skeleton.TriggerUpdate()
+
+	m.status.Reset()
+	m.status.SetProgressMessage(fmt.Sprintf("[%s] Fetching tags...",
+		m.selectedRepository.RepositoryName))
+
+	tags, err := m.github.GetRepositoryTags(ctx, gu.GetRepositoryTagsInput{
+		Repository: m.selectedRepository.RepositoryName,
+	})
+	if err != nil {
+		m.tags = nil
+		m.refreshSuggestions()
+		if !errors.Is(err, context.Canceled) {
+			m.status.SetError(err)
+			m.status.SetErrorMessage("Tags cannot be listed")
+		}
+		return
+	}
+
+	tagNames := make([]string, len(tags.Tags))
+	for i, tag := range tags.Tags {
+		tagNames[i] = tag.Name
+	}
+
+	m.tags = tagNames
+	m.refreshSuggestions()
+	m.status.SetSuccessMessage(fmt.Sprintf("[%s] Tags fetched.",
+		m.selectedRepository.RepositoryName))
+}
+
 // -----------------------------------------------------------------------------
 // Table Management
 // -----------------------------------------------------------------------------
 
-func (m *ModelGithubWorkflow) updateWorkflowTable(workflows []gu.TriggerableWorkflow) {
+func (m *ModelGithubWorkflow) updateWorkflowTable(workflows []gu.WorkflowWithTriggers) {
 	rows := make([]table.Row, 0, len(workflows))
 	for _, workflow := range workflows {
-		rows = append(rows, table.Row{
+		row := table.Row{
 			workflow.Name,
 			workflow.Path,
-		})
+			strings.ReplaceAll(workflow.State, "_", " "),
+		}
+		if m.showAllWorkflows {
+			row = append(row, strings.Join(workflow.Triggers, ", "))
+		}
+		rows = append(rows, row)
 	}
 
 	sort.SliceStable(rows, func(i, j int) bool {
 		return rows[i][0] < rows[j][0]
 	})
+	// The rows are matched with the workflows by their paths, only the rows are sorted
+	sortTable[gu.WorkflowWithTriggers](m.sort, rows, nil)
 
 	m.tableTriggerableWorkflow.SetRows(rows)
 	if len(rows) > 0 {
@@ -409,8 +721,27 @@ func (m *ModelGithubWorkflow) updateWorkflowTable(workflows []gu.TriggerableWork
 	}
 }
 
+// resortWorkflowTable sorts the table again and keeps the cursor on the selected workflow
+func (m *ModelGithubWorkflow) resortWorkflowTable() {
+	if !m.tableReady {
+		return
+	}
+
+	selected, ok := m.selectedWorkflow()
+	m.updateWorkflowTable(m.visibleWorkflows())
+	if ok {
+		for i, row := range m.tableTriggerableWorkflow.Rows() {
+			if row[1] == selected.Path {
+				m.tableTriggerableWorkflow.SetCursor(i)
+				break
+			}
+		}
+	}
+	m.status.SetDefaultMessage(fmt.Sprintf("[%s] %s", m.selectedRepository.RepositoryName,
+		m.sort.describe(m.workflowColumns())))
+}
+
 func (m *ModelGithubWorkflow) finalizeUpdate() {
-	m.tableReady = true
 	m.status.SetSuccessMessage(fmt.Sprintf("[%s@%s] Triggerable workflows fetched.",
 		m.selectedRepository.RepositoryName, m.selectedRepository.BranchName))
 }
@@ -420,11 +751,26 @@ func (m *ModelGithubWorkflow) handleTableInputs() {
 		return
 	}
 
-	rows := m.tableTriggerableWorkflow.Rows()
-	selectedRow := m.tableTriggerableWorkflow.SelectedRow()
-	if len(rows) > 0 && len(selectedRow) > 0 {
-		m.selectedRepository.WorkflowName = selectedRow[1]
+	workflow, ok := m.selectedWorkflow()
+	if !ok {
+		return
+	}
+
+	// Only dispatchable workflows can be triggered, the others are listed for their history and file
+	if workflow.Dispatchable {
+		m.selectedRepository.WorkflowName = workflow.Path
+	} else {
+		m.selectedRepository.WorkflowName = ""
+		m.skeleton.LockTab("trigger")
+	}
+}
+
+// workflowColumns returns the table columns of the selected view
+func (m *ModelGithubWorkflow) workflowColumns() []table.Column {
+	if m.showAllWorkflows {
+		return tableColumnsAllWorkflows
 	}
+	return tableColumnsWorkflow
 }
 
 // -----------------------------------------------------------------------------
@@ -449,10 +795,15 @@ func (m *ModelGithubWorkflow) renderBranchInput() string {
 		Width(m.skeleton.GetTerminalWidth() - 6).
 		MarginLeft(1)
 
-	if len(m.textInput.AvailableSuggestions()) > 0 && m.textInput.Value() == "" {
-		if !m.state.Repository.HasFlows {
-			m.textInput.Placeholder = "Branch selection disabled - No triggerable workflows available"
-		} else {
+	if m.textInput.Value() == "" {
+		switch {
+		case !m.state.Repository.HasFlows:
+			m.textInput.Placeholder = "Ref selection disabled - No triggerable workflows available"
+		case m.refMode == refModeTag:
+			m.textInput.Placeholder = fmt.Sprintf("Type to switch tag (default branch: %s)", m.state.Repository.Branch)
+		case m.refMode == refModeFree:
+			m.textInput.Placeholder = fmt.Sprintf("Type any git ref (default branch: %s)", m.state.Repository.Branch)
+		default:
 			m.textInput.Placeholder = fmt.Sprintf("Type to switch branch (default: %s)", m.state.Repository.Branch)
 		}
 	}
@@ -460,6 +811,61 @@ func (m *ModelGithubWorkflow) renderBranchInput() string {
 	return style.Render(m.textInput.View())
 }
 
+// maxVisibleSuggestions is the number of suggestions rendered under the ref input at once
+const maxVisibleSuggestions = 5
+
+func (m *ModelGithubWorkflow) suggestionsHeight() int {
+	if len(m.suggestions) == 0 {
+		return 0
+	}
+	return min(len(m.suggestions), maxVisibleSuggestions) + 2 // borders
+}
+
+func (m *ModelGithubWorkflow) renderSuggestions() string {
+	if len(m.suggestions) == 0 {
+		return ""
+	}
+
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		Padding(0, 1).
+		Width(m.skeleton.GetTerminalWidth() - 6).
+		MarginLeft(1)
+
+	selectedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("229")).Background(lipgloss.Color("57"))
+	defaultBadge := lipgloss.NewStyle().Foreground(lipgloss.Color("120")).Render(" default")
+	protectedBadge := lipgloss.NewStyle().Foreground(lipgloss.Color("208")).Render(" protected")
+
+	// Scroll the visible window with the cursor
+	start := max(0, m.suggestionCursor-maxVisibleSuggestions+1)
+	end := min(len(m.suggestions), start+maxVisibleSuggestions)
+
+	var lines []string
+	for i := start; i < end; i++ {
+		suggestion := m.suggestions[i]
+
+		line := suggestion.Value
+		if i == m.suggestionCursor {
+			line = selectedStyle.Render(line)
+		}
+
+		if m.refMode == refModeBranch && suggestion.Index < len(m.branches) {
+			branch := m.branches[suggestion.Index]
+			if branch.IsDefault {
+				line += defaultBadge
+			}
+			if branch.IsProtected {
+				line += protectedBadge
+			}
+		}
+
+		lines = append(lines, line)
+	}
+
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
 func (m *ModelGithubWorkflow) renderHelp() string {
 	helpStyle := WindowStyleHelp.Width(m.skeleton.GetTerminalWidth() - 4)
 	return helpStyle.Render(m.help.View(m.keys))
@@ -469,18 +875,210 @@ func (m *ModelGithubWorkflow) updateTableDimensions() {
 	termWidth := m.skeleton.GetTerminalWidth()
 	termHeight := m.skeleton.GetTerminalHeight()
 
+	columns := m.workflowColumns()
+
 	var tableWidth int
-	for _, t := range tableColumnsWorkflow {
+	for _, t := range columns {
 		tableWidth += t.Width
 	}
 
-	newTableColumns := tableColumnsWorkflow
+	newTableColumns := make([]table.Column, len(columns))
+	copy(newTableColumns, columns)
+	for i := range newTableColumns {
+		newTableColumns[i].Title = m.sort.title(i, newTableColumns[i].Title)
+	}
+
+	// Extra width goes to the file column, or to the triggers column when all workflows are listed
+	widestColumn := 1
+	if m.showAllWorkflows {
+		widestColumn = len(newTableColumns) - 1
+	}
+
 	widthDiff := termWidth - tableWidth
 	if widthDiff > 0 {
-		newTableColumns[1].Width += widthDiff - 10
+		newTableColumns[widestColumn].Width += widthDiff - 10
 		m.tableTriggerableWorkflow.SetColumns(newTableColumns)
-		m.tableTriggerableWorkflow.SetHeight(termHeight - 17)
+		m.tableTriggerableWorkflow.SetHeight(termHeight - 20 - m.suggestionsHeight())
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Option Management
+// -----------------------------------------------------------------------------
+
+func (m *ModelGithubWorkflow) setupOptions() {
+	m.modelTabOptions.AddOption("View file", m.viewWorkflowFile)
+	m.modelTabOptions.AddOption("Enable", m.enableWorkflow)
+	m.modelTabOptions.AddOption("Disable", m.disableWorkflow)
+	m.modelTabOptions.AddOption("Browse file", m.openFileInBrowser)
+	m.modelTabOptions.AddOption("Browse history", m.openHistoryInBrowser)
+}
+
+// optionKeyMsg returns the key for the tab options if the key belongs to them instead of the ref input.
+// Options are selected with their number keys and alt while the ref input is focused, and without alt otherwise.
+// Enter only goes to the options to confirm a selected option.
+func (m *ModelGithubWorkflow) optionKeyMsg(msg tea.KeyMsg) (tea.KeyMsg, bool) {
+	if msg.Type == tea.KeyEnter {
+		return msg, m.modelTabOptions.Selected()
+	}
+	if msg.Type != tea.KeyRunes || len(msg.Runes) != 1 || msg.Runes[0] < '0' || msg.Runes[0] > '9' {
+		return msg, false
+	}
+	if msg.Alt {
+		msg.Alt = false
+		return msg, true
+	}
+	return msg, !m.textInput.Focused()
+}
+
+// selectedWorkflow returns the workflow of the selected row
+func (m *ModelGithubWorkflow) selectedWorkflow() (gu.WorkflowWithTriggers, bool) {
+	selectedRow := m.tableTriggerableWorkflow.SelectedRow()
+	if len(selectedRow) < 2 {
+		return gu.WorkflowWithTriggers{}, false
+	}
+
+	for _, workflow := range m.workflows {
+		if workflow.Path == selectedRow[1] {
+			return workflow, true
+		}
+	}
+	return gu.WorkflowWithTriggers{}, false
+}
+
+func (m *ModelGithubWorkflow) enableWorkflow() {
+	workflow, ok := m.selectedWorkflow()
+	if !ok {
+		m.status.SetErrorMessage("No workflow selected")
+		return
+	}
+
+	m.status.SetProgressMessage(fmt.Sprintf("Enabling %s...", workflow.Name))
+
+	if err := m.github.EnableWorkflow(context.Background(), gu.EnableWorkflowInput{
+		Repository: m.selectedRepository.RepositoryName,
+		WorkflowID: workflow.ID,
+	}); err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage("Failed to enable workflow")
+		return
+	}
+
+	m.status.SetSuccessMessage(fmt.Sprintf("Enabled %s", workflow.Name))
+	m.syncWorkflows()
+}
+
+func (m *ModelGithubWorkflow) disableWorkflow() {
+	workflow, ok := m.selectedWorkflow()
+	if !ok {
+		m.status.SetErrorMessage("No workflow selected")
+		return
+	}
+
+	m.status.SetProgressMessage(fmt.Sprintf("Disabling %s...", workflow.Name))
+
+	if err := m.github.DisableWorkflow(context.Background(), gu.DisableWorkflowInput{
+		Repository: m.selectedRepository.RepositoryName,
+		WorkflowID: workflow.ID,
+	}); err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage("Failed to disable workflow")
+		return
+	}
+
+	m.status.SetSuccessMessage(fmt.Sprintf("Disabled %s", workflow.Name))
+	m.syncWorkflows()
+}
+
+func (m *ModelGithubWorkflow) viewWorkflowFile() {
+	workflow, ok := m.selectedWorkflow()
+	if !ok {
+		m.status.SetErrorMessage("No workflow selected")
+		return
+	}
+
+	ref := m.selectedRepository.BranchName
+	m.status.SetProgressMessage(fmt.Sprintf("[%s@%s] Fetching %s...",
+		m.selectedRepository.RepositoryName, ref, workflow.Path))
+
+	file, err := m.github.GetWorkflowFile(context.Background(), gu.GetWorkflowFileInput{
+		Repository:   m.selectedRepository.RepositoryName,
+		Branch:       ref,
+		WorkflowFile: workflow.Path,
+	})
+	if err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage("Workflow file cannot be fetched")
+		return
+	}
+
+	m.fileViewer.Open(workflow.Path, ref, file)
+	m.setFileViewerKeys(true)
+	m.status.SetSuccessMessage(fmt.Sprintf("[%s@%s] %s fetched.",
+		m.selectedRepository.RepositoryName, ref, workflow.Path))
+	m.skeleton.TriggerUpdate()
+}
+
+func (m *ModelGithubWorkflow) closeWorkflowFile() {
+	m.fileViewer.Close()
+	m.setFileViewerKeys(false)
+}
+
+// setFileViewerKeys shows the keys of the file viewer in the help while it is open
+func (m *ModelGithubWorkflow) setFileViewerKeys(visible bool) {
+	m.keys.SwitchRefMode.SetEnabled(!visible)
+	m.keys.ToggleAllWorkflows.SetEnabled(!visible)
+	m.keys.NextSuggestion.SetEnabled(!visible)
+	m.keys.PrevSuggestion.SetEnabled(!visible)
+	m.keys.AcceptSuggestion.SetEnabled(!visible)
+	m.keys.SortBy.SetEnabled(!visible)
+	m.keys.SortOrder.SetEnabled(!visible)
+	m.keys.ScrollFile.SetEnabled(visible)
+	m.keys.ToggleGraph.SetEnabled(visible)
+	m.keys.CloseFile.SetEnabled(visible)
+}
+
+func (m *ModelGithubWorkflow) openFileInBrowser() {
+	workflow, ok := m.selectedWorkflow()
+	if !ok {
+		m.status.SetErrorMessage("No workflow selected")
+		return
+	}
+
+	m.status.SetProgressMessage("Opening in browser...")
+
+	url := fmt.Sprintf("https://github.com/%s/blob/%s/%s",
+		m.selectedRepository.RepositoryName,
+		m.selectedRepository.BranchName,
+		workflow.Path)
+
+	if err := browser.OpenInBrowser(url); err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage("Failed to open in browser")
+		return
+	}
+	m.status.SetSuccessMessage("Opened in browser")
+}
+
+func (m *ModelGithubWorkflow) openHistoryInBrowser() {
+	workflow, ok := m.selectedWorkflow()
+	if !ok {
+		m.status.SetErrorMessage("No workflow selected")
+		return
+	}
+
+	m.status.SetProgressMessage("Opening in browser...")
+
+	url := fmt.Sprintf("https://github.com/%s/actions/workflows/%s",
+		m.selectedRepository.RepositoryName,
+		path.Base(workflow.Path))
+
+	if err := browser.OpenInBrowser(url); err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage("Failed to open in browser")
+		return
 	}
+	m.status.SetSuccessMessage("Opened in browser")
 }
 
 // -----------------------------------------------------------------------------
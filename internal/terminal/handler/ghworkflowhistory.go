@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/termkit/gama/internal/config"
@@ -32,19 +35,45 @@ type ModelGithubWorkflowHistory struct {
 	tableWorkflowHistory table.Model
 	status               *ModelStatus
 	modelTabOptions      *ModelTabOptions
+	graphViewer          *ModelWorkflowGraph
+	runDetail            *ModelRunDetail
+	statistics           *ModelWorkflowStatistics
+	pendingDeployments   *ModelPendingDeployments
+	deleteRun            *ModelDeleteRun
+	filterInput          textinput.Model
+	columnPicker         *ModelColumnPicker
 
 	// Table state
 	tableReady     bool
 	tableStyle     lipgloss.Style
 	workflows      []gu.Workflow
 	lastRepository string
+	columns        []tableColumn[gu.Workflow] // columns of the table in their order, they are set in the config
+	sort           tableSort
+
+	// Filter state, the filter is applied to the listed runs while it is typed and sent to GitHub when it is applied
+	filter           gu.RunFilter
+	appliedFilter    gu.RunFilter
+	appliedQuery     string        // text of the applied filter, it is typed again if the filter bar is closed
+	visibleWorkflows []gu.Workflow // runs of the table, the ones of workflows which match the filter
 
 	// Live mode state
-	liveMode         bool
+	liveMode         *LiveMode
 	liveModeInterval time.Duration
 
+	// Re-runs write debug logs if it is set, it is switched in the options
+	debugLogging bool
+
+	// rerunJobConfirmation is the job of the graph which is re-run if enter is pressed, nil if none is selected
+	rerunJobConfirmation *gu.RunJob
+
+	// Bulk state, the run options act on the marked runs if there are any
+	marked *runMarks
+
 	// Workflow state
 	selectedWorkflowID int64
+	graphRunID         int64       // run of the graph in the graph viewer
+	statisticsWorkflow gu.Workflow // a run of the workflow of the statistics
 
 	// Context management
 	syncWorkflowHistoryContext context.Context
@@ -76,26 +105,45 @@ func SetupModelGithubWorkflowHistory(s *skeleton.Skeleton, githubUseCase gu.UseC
 		github:   githubUseCase,
 
 		// Initialize UI components
-		Help:            help.New(),
-		keys:            githubWorkflowHistoryKeys,
-		status:          modelStatus,
-		modelTabOptions: tabOptions,
+		Help:               help.New(),
+		keys:               githubWorkflowHistoryKeys,
+		status:             modelStatus,
+		modelTabOptions:    tabOptions,
+		graphViewer:        SetupModelWorkflowGraph(s),
+		runDetail:          SetupModelRunDetail(s),
+		statistics:         SetupModelWorkflowStatistics(s),
+		pendingDeployments: SetupModelPendingDeployments(s),
+		deleteRun:          SetupModelDeleteRun(s),
+		filterInput:        setupFilterInput(),
+		columnPicker:       SetupModelColumnPicker(),
 
 		// Initialize state
 		selectedRepository:         NewSelectedRepository(),
 		syncWorkflowHistoryContext: context.Background(),
 		cancelSyncWorkflowHistory:  func() {},
-		liveMode:                   cfg.Settings.LiveMode.Enabled,
+		liveMode:                   NewLiveMode(s, cfg.Settings.LiveMode.Enabled),
 		liveModeInterval:           cfg.Settings.LiveMode.Interval,
 		tableStyle:                 setupTableStyle(),
+		columns:                    selectColumns(workflowHistoryColumns, cfg.Settings.Columns.History),
+		sort:                       newTableSort(),
+		marked:                     newRunMarks(),
 	}
 
 	// Setup table
-	m.tableWorkflowHistory = setupWorkflowHistoryTable()
+	m.tableWorkflowHistory = setupWorkflowHistoryTable(fitColumns(m.columns, m.sort, 0))
 
 	return m
 }
 
+func setupFilterInput() textinput.Model {
+	ti := textinput.New()
+	ti.Blur()
+	ti.CharLimit = 256
+	ti.Placeholder = "Press / to filter runs by text or " + strings.Join(gu.RunFilterKeys, ": ") + ":"
+	ti.ShowSuggestions = false
+	return ti
+}
+
 func setupTableStyle() lipgloss.Style {
 	return lipgloss.NewStyle().
 		BorderStyle(lipgloss.NormalBorder()).
@@ -103,9 +151,9 @@ func setupTableStyle() lipgloss.Style {
 		MarginLeft(1)
 }
 
-func setupWorkflowHistoryTable() table.Model {
+func setupWorkflowHistoryTable(columns []table.Column) table.Model {
 	t := table.New(
-		table.WithColumns(tableColumnsWorkflowHistory),
+		table.WithColumns(columns),
 		table.WithRows([]table.Row{}),
 		table.WithFocused(true),
 		table.WithHeight(7),
@@ -150,13 +198,52 @@ func (m *ModelGithubWorkflowHistory) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	m.handleRepositoryChange()
 
 	cursor := m.tableWorkflowHistory.Cursor()
-	if m.workflows != nil && cursor >= 0 && cursor < len(m.workflows) {
-		m.selectedWorkflowID = m.workflows[cursor].ID
+	if cursor >= 0 && cursor < len(m.visibleWorkflows) {
+		m.selectedWorkflowID = m.visibleWorkflows[cursor].ID
+	} else if m.workflows != nil {
+		// Every run is filtered out
+		m.selectedWorkflowID = 0
 	}
 
 	var cmds []tea.Cmd
 	var cmd tea.Cmd
 
+	// The column picker takes the keys while it is open
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.columnPicker.Visible() {
+		m.handleColumnPickerKeyMsg(keyMsg)
+		return m, nil
+	}
+
+	// The filter bar takes the keys while it is focused
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.filterInput.Focused() {
+		return m, m.handleFilterKeyMsg(keyMsg)
+	}
+
+	// The graph viewer takes the keys while it is open
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.graphViewer.Visible() {
+		return m, m.handleGraphKeyMsg(keyMsg)
+	}
+
+	// The run detail takes the keys while it is open
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.runDetail.Visible() {
+		return m, m.handleDetailKeyMsg(keyMsg)
+	}
+
+	// The statistics take the keys while they are open
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.statistics.Visible() {
+		return m, m.handleStatisticsKeyMsg(keyMsg)
+	}
+
+	// The pending deployments take the keys while they are open, the options still approve and reject them
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.pendingDeployments.Visible() {
+		return m, m.handlePendingKeyMsg(keyMsg)
+	}
+
+	// The confirmation of a deletion takes the keys while it is open
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.deleteRun.Visible() {
+		return m, m.handleDeleteKeyMsg(keyMsg)
+	}
+
 	// Handle different message types
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
@@ -180,8 +267,60 @@ func (m *ModelGithubWorkflowHistory) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m *ModelGithubWorkflowHistory) View() string {
+	if m.columnPicker.Visible() {
+		helpStyle := WindowStyleHelp.Width(m.skeleton.GetTerminalWidth() - 4)
+		return lipgloss.JoinVertical(lipgloss.Top,
+			m.columnPicker.View(m.skeleton.GetTerminalWidth()-6),
+			m.status.View(),
+			helpStyle.Render(m.columnPicker.ViewHelp()),
+		)
+	}
+
+	if m.graphViewer.Visible() {
+		return lipgloss.JoinVertical(lipgloss.Top,
+			m.graphViewer.View(m.skeleton.GetTerminalHeight()-10),
+			m.status.View(),
+			m.renderHelp(),
+		)
+	}
+
+	if m.runDetail.Visible() {
+		return lipgloss.JoinVertical(lipgloss.Top,
+			m.runDetail.View(m.skeleton.GetTerminalHeight()-10),
+			m.status.View(),
+			m.renderHelp(),
+		)
+	}
+
+	if m.statistics.Visible() {
+		return lipgloss.JoinVertical(lipgloss.Top,
+			m.statistics.View(m.skeleton.GetTerminalHeight()-10),
+			m.status.View(),
+			m.renderHelp(),
+		)
+	}
+
+	if m.pendingDeployments.Visible() {
+		// The options row takes 3 lines
+		return lipgloss.JoinVertical(lipgloss.Top,
+			m.pendingDeployments.View(m.skeleton.GetTerminalHeight()-13),
+			m.modelTabOptions.View(),
+			m.status.View(),
+			m.renderHelp(),
+		)
+	}
+
+	if m.deleteRun.Visible() {
+		return lipgloss.JoinVertical(lipgloss.Top,
+			m.deleteRun.View(),
+			m.status.View(),
+			m.renderHelp(),
+		)
+	}
+
 	return lipgloss.JoinVertical(lipgloss.Top,
 		m.renderTable(),
+		m.renderFilterBar(),
 		m.modelTabOptions.View(),
 		m.status.View(),
 		m.renderHelp(),
@@ -199,19 +338,254 @@ func (m *ModelGithubWorkflowHistory) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
 		return nil
 	case key.Matches(msg, m.keys.LiveMode):
 		return m.toggleLiveMode()
+	case key.Matches(msg, m.keys.Filter):
+		m.setFilterKeys(true)
+		return m.filterInput.Focus()
+	case key.Matches(msg, m.keys.SortBy):
+		m.sort.next(len(m.columns))
+		m.resortWorkflowTable()
+		return nil
+	case key.Matches(msg, m.keys.SortOrder):
+		m.sort.reverse()
+		m.resortWorkflowTable()
+		return nil
+	case key.Matches(msg, m.keys.Columns):
+		m.columnPicker.Open("Columns of the workflow history", columnChoices(workflowHistoryColumns, m.columns))
+		return nil
+	case key.Matches(msg, m.keys.Detail):
+		m.viewRunDetail()
+		return nil
+	case key.Matches(msg, m.keys.Statistics):
+		m.viewWorkflowStatistics()
+		return nil
+	case key.Matches(msg, m.keys.Pending):
+		m.viewPendingDeployments()
+		return nil
+	case key.Matches(msg, m.keys.Mark):
+		m.toggleMark()
+		return nil
+	case key.Matches(msg, m.keys.ClearMarks):
+		m.clearMarks()
+		return nil
 	}
 	return nil
 }
 
+func (m *ModelGithubWorkflowHistory) handleColumnPickerKeyMsg(msg tea.KeyMsg) {
+	if m.columnPicker.Update(msg) != columnPickerSaved {
+		return
+	}
+
+	m.columns = selectColumns(workflowHistoryColumns, m.columnPicker.Keys())
+	m.sort = newTableSort()
+
+	// The rows have to match the columns, they are cleared before the columns are replaced
+	m.tableWorkflowHistory.SetRows([]table.Row{})
+	m.tableWorkflowHistory.SetColumns(fitColumns(m.columns, m.sort, 0))
+	m.updateTableDimensions()
+	m.updateWorkflowTable()
+
+	if err := config.SaveColumns("history", columnKeys(m.columns)); err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage(fmt.Sprintf("Columns cannot be saved: %v", err))
+		return
+	}
+	m.status.SetSuccessMessage("Columns saved to the config")
+}
+
+// resortWorkflowTable sorts the table again and keeps the cursor on the selected run
+func (m *ModelGithubWorkflowHistory) resortWorkflowTable() {
+	m.updateTableDimensions()
+	m.updateWorkflowTable()
+	for i, workflow := range m.visibleWorkflows {
+		if workflow.ID == m.selectedWorkflowID {
+			m.tableWorkflowHistory.SetCursor(i)
+			break
+		}
+	}
+	m.status.SetDefaultMessage(fmt.Sprintf("[%s] %s", m.selectedRepository.RepositoryName,
+		m.sort.describe(m.tableWorkflowHistory.Columns())))
+}
+
+func (m *ModelGithubWorkflowHistory) handleFilterKeyMsg(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, m.keys.ApplyFilter):
+		// Refetch the runs with the tokens GitHub can filter by
+		m.appliedFilter = m.filter
+		m.appliedQuery = m.filterInput.Value()
+		m.filterInput.Blur()
+		m.setFilterKeys(false)
+		go m.syncWorkflowHistory(m.syncWorkflowHistoryContext)
+		return nil
+	case key.Matches(msg, m.keys.CloseFilter):
+		// The typed filter is dropped, the table shows the runs of the filter they are fetched with
+		m.filterInput.SetValue(m.appliedQuery)
+		m.filterInput.Blur()
+		m.setFilterKeys(false)
+		if m.filter.String() != m.appliedFilter.String() {
+			m.filter = m.appliedFilter
+			m.updateWorkflowTable()
+			m.tableWorkflowHistory.SetCursor(0)
+		}
+		m.status.SetDefaultMessage(fmt.Sprintf("[%s] %s", m.selectedRepository.RepositoryName, m.filterMessage()))
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.filterInput, cmd = m.filterInput.Update(msg)
+	if filter := gu.ParseRunFilter(m.filterInput.Value()); filter.String() != m.filter.String() {
+		m.filter = filter
+		m.updateWorkflowTable()
+		m.tableWorkflowHistory.SetCursor(0)
+		m.status.SetDefaultMessage(fmt.Sprintf("[%s] %s", m.selectedRepository.RepositoryName, m.filterMessage()))
+	}
+	return cmd
+}
+
 func (m *ModelGithubWorkflowHistory) handleUpdateMsg(msg workflowHistoryUpdateMsg) tea.Cmd {
 	go func() {
 		time.Sleep(msg.UpdateAfter)
 		m.syncWorkflowHistory(m.syncWorkflowHistoryContext)
+		if m.graphViewer.Visible() {
+			// Live mode follows the run in the graph too
+			m.refreshRunGraph()
+		}
+		if m.runDetail.Visible() {
+			m.showRunAttempt(m.runDetail.Detail().Attempt)
+		}
+		if m.pendingDeployments.Visible() {
+			m.showPendingDeployments(m.pendingDeployments.Run())
+		}
 		m.skeleton.TriggerUpdate()
 	}()
 	return nil
 }
 
+func (m *ModelGithubWorkflowHistory) handleGraphKeyMsg(msg tea.KeyMsg) tea.Cmd {
+	// Like the options, a job is re-run only if it is confirmed with enter, any other key keeps it
+	if job := m.rerunJobConfirmation; job != nil {
+		m.setRerunJobConfirmation(nil)
+		if key.Matches(msg, m.keys.ConfirmReRunJob) {
+			go m.rerunJob(*job)
+			return nil
+		}
+		m.status.SetDefaultMessage(fmt.Sprintf("Job %s is not re-run", job.Name))
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.CloseGraph):
+		m.closeRunGraph()
+		return nil
+	case key.Matches(msg, m.keys.Refresh):
+		go func() {
+			m.refreshRunGraph()
+			m.skeleton.TriggerUpdate()
+		}()
+		return nil
+	case key.Matches(msg, m.keys.LiveMode):
+		return m.toggleLiveMode()
+	case key.Matches(msg, m.keys.NextJob):
+		m.graphViewer.NextJob()
+		return nil
+	case key.Matches(msg, m.keys.PrevJob):
+		m.graphViewer.PrevJob()
+		return nil
+	case key.Matches(msg, m.keys.ReRunJob):
+		job, ok := m.graphViewer.SelectedJob()
+		if !ok {
+			m.status.SetErrorMessage("No job selected")
+			return nil
+		}
+		m.setRerunJobConfirmation(&job)
+		m.status.SetProgressMessage(areYouSure(fmt.Sprintf("re-run job %s and the jobs which depend on it%s",
+			job.Name, m.debugLoggingNote())))
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.graphViewer, cmd = m.graphViewer.Update(msg)
+	return cmd
+}
+
+func (m *ModelGithubWorkflowHistory) handleDetailKeyMsg(msg tea.KeyMsg) tea.Cmd {
+	detail := m.runDetail.Detail()
+
+	switch {
+	case key.Matches(msg, m.keys.CloseDetail):
+		m.closeRunDetail()
+	case key.Matches(msg, m.keys.PrevAttempt):
+		if detail.Attempt > 1 {
+			go m.showRunAttempt(detail.Attempt - 1)
+		}
+	case key.Matches(msg, m.keys.NextAttempt):
+		if detail.Attempt < detail.Attempts {
+			go m.showRunAttempt(detail.Attempt + 1)
+		}
+	case key.Matches(msg, m.keys.Refresh):
+		go m.showRunAttempt(detail.Attempt)
+	case key.Matches(msg, m.keys.LiveMode):
+		return m.toggleLiveMode()
+	}
+	return nil
+}
+
+func (m *ModelGithubWorkflowHistory) handleStatisticsKeyMsg(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, m.keys.CloseStatistics):
+		m.closeWorkflowStatistics()
+	case key.Matches(msg, m.keys.PrevWindow):
+		m.statistics.PrevWindow()
+	case key.Matches(msg, m.keys.NextWindow):
+		m.statistics.NextWindow()
+	case key.Matches(msg, m.keys.Refresh):
+		go m.showWorkflowStatistics(m.statisticsWorkflow)
+	}
+	return nil
+}
+
+func (m *ModelGithubWorkflowHistory) handlePendingKeyMsg(msg tea.KeyMsg) tea.Cmd {
+	if m.pendingDeployments.EditingComment() {
+		if key.Matches(msg, m.keys.FinishComment) {
+			m.pendingDeployments.FinishComment()
+			m.setCommentKeys(false)
+			return nil
+		}
+		return m.pendingDeployments.UpdateComment(msg)
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.ClosePending):
+		m.closePendingDeployments()
+		return nil
+	case key.Matches(msg, m.keys.EditComment):
+		m.setCommentKeys(true)
+		return m.pendingDeployments.EditComment()
+	case key.Matches(msg, m.keys.Refresh):
+		go m.showPendingDeployments(m.pendingDeployments.Run())
+		return nil
+	case key.Matches(msg, m.keys.LiveMode):
+		return m.toggleLiveMode()
+	}
+
+	var cmd tea.Cmd
+	m.modelTabOptions, cmd = m.modelTabOptions.Update(msg)
+	return cmd
+}
+
+func (m *ModelGithubWorkflowHistory) handleDeleteKeyMsg(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, m.keys.CloseDelete):
+		m.closeDeleteRun()
+		m.status.SetDefaultMessage("The run is kept")
+		return nil
+	case key.Matches(msg, m.keys.ConfirmDelete):
+		m.confirmDeleteRun()
+		return nil
+	}
+
+	return m.deleteRun.Update(msg)
+}
+
 // -----------------------------------------------------------------------------
 // Live Mode Management
 // -----------------------------------------------------------------------------
@@ -223,7 +597,7 @@ func (m *ModelGithubWorkflowHistory) startLiveMode() {
 		for {
 			select {
 			case <-ticker.C:
-				if m.liveMode {
+				if m.liveMode.Enabled() {
 					m.skeleton.TriggerUpdateWithMsg(workflowHistoryUpdateMsg{
 						UpdateAfter: time.Nanosecond,
 					})
@@ -236,20 +610,15 @@ func (m *ModelGithubWorkflowHistory) startLiveMode() {
 }
 
 func (m *ModelGithubWorkflowHistory) toggleLiveMode() tea.Cmd {
-	m.liveMode = !m.liveMode
-
-	status := "Off"
 	message := "Live mode disabled"
 
-	if m.liveMode {
-		status = "On"
+	if m.liveMode.Toggle() {
 		message = "Live mode enabled"
 		// Trigger immediate update when enabling
 		go m.syncWorkflowHistory(m.syncWorkflowHistoryContext)
 	}
 
 	m.status.SetSuccessMessage(message)
-	m.skeleton.UpdateWidgetValue("live", fmt.Sprintf("Live Mode: %s", status))
 
 	return nil
 }
@@ -268,6 +637,12 @@ func (m *ModelGithubWorkflowHistory) handleRepositoryChange() tea.Cmd {
 	}
 
 	m.lastRepository = m.selectedRepository.RepositoryName
+	m.marked.Clear()
+	m.closeRunGraph()
+	m.closeRunDetail()
+	m.closeWorkflowStatistics()
+	m.closePendingDeployments()
+	m.closeDeleteRun()
 	m.syncWorkflowHistoryContext, m.cancelSyncWorkflowHistory = context.WithCancel(context.Background())
 
 	go m.syncWorkflowHistory(m.syncWorkflowHistoryContext)
@@ -325,12 +700,14 @@ func (m *ModelGithubWorkflowHistory) initializeSyncState() {
 func (m *ModelGithubWorkflowHistory) clearWorkflowHistory() {
 	m.tableWorkflowHistory.SetRows([]table.Row{})
 	m.workflows = nil
+	m.visibleWorkflows = nil
 }
 
 func (m *ModelGithubWorkflowHistory) fetchWorkflowHistory(ctx context.Context) (*gu.GetWorkflowHistoryOutput, error) {
 	history, err := m.github.GetWorkflowHistory(ctx, gu.GetWorkflowHistoryInput{
 		Repository: m.selectedRepository.RepositoryName,
 		Branch:     m.selectedRepository.BranchName,
+		Filter:     m.appliedFilter,
 	})
 
 	if err != nil {
@@ -355,27 +732,36 @@ func (m *ModelGithubWorkflowHistory) processWorkflowHistory(history *gu.GetWorkf
 	}
 
 	m.workflows = history.Workflows
+	m.pruneMarks()
 	m.updateWorkflowTable()
 	m.finalizeUpdate()
 }
 
 func (m *ModelGithubWorkflowHistory) handleEmptyWorkflowHistory() {
 	m.modelTabOptions.SetStatus(StatusNone)
+	if !m.appliedFilter.IsEmpty() {
+		m.status.SetDefaultMessage(fmt.Sprintf("[%s] No workflow runs match the filter %s",
+			m.selectedRepository.RepositoryName, m.appliedFilter))
+		return
+	}
 	m.status.SetDefaultMessage(fmt.Sprintf("[%s] No workflow history found.",
 		m.selectedRepository.RepositoryName))
 }
 
 func (m *ModelGithubWorkflowHistory) updateWorkflowTable() {
-	rows := make([]table.Row, 0, len(m.workflows))
+	m.visibleWorkflows = m.visibleWorkflows[:0]
 	for _, workflow := range m.workflows {
-		rows = append(rows, table.Row{
-			workflow.WorkflowName,
-			workflow.ActionName,
-			workflow.TriggeredBy,
-			workflow.StartedAt,
-			workflow.Status,
-			workflow.Duration,
-		})
+		if m.filter.Match(workflow) {
+			m.visibleWorkflows = append(m.visibleWorkflows, workflow)
+		}
+	}
+
+	rows := columnRows(m.columns, m.visibleWorkflows)
+	sortTable(m.sort, rows, m.visibleWorkflows)
+	for i, workflow := range m.visibleWorkflows {
+		if m.marked.Has(workflow.ID) && len(rows[i]) > 0 {
+			rows[i][0] = "● " + rows[i][0]
+		}
 	}
 	m.tableWorkflowHistory.SetRows(rows)
 }
@@ -384,7 +770,19 @@ func (m *ModelGithubWorkflowHistory) finalizeUpdate() {
 	m.tableReady = true
 	m.tableWorkflowHistory.SetCursor(0)
 	m.modelTabOptions.SetStatus(StatusIdle)
-	m.status.SetSuccessMessage(fmt.Sprintf("[%s] Workflow history fetched.", m.selectedRepository.RepositoryName))
+	message := fmt.Sprintf("[%s] Workflow history fetched.", m.selectedRepository.RepositoryName)
+	if !m.filter.IsEmpty() {
+		message += " " + m.filterMessage()
+	}
+	m.status.SetSuccessMessage(message)
+}
+
+// filterMessage describes the active filter and how many of the listed runs match it
+func (m *ModelGithubWorkflowHistory) filterMessage() string {
+	if m.filter.IsEmpty() {
+		return fmt.Sprintf("Filter cleared, %d runs", len(m.workflows))
+	}
+	return fmt.Sprintf("Filter: %s (%d of %d runs)", m.filter, len(m.visibleWorkflows), len(m.workflows))
 }
 
 // -----------------------------------------------------------------------------
@@ -415,6 +813,21 @@ func (m *ModelGithubWorkflowHistory) renderTable() string {
 	return m.tableStyle.Render(m.tableWorkflowHistory.View())
 }
 
+func (m *ModelGithubWorkflowHistory) renderFilterBar() string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("#3b698f")).
+		Padding(0, 1).
+		Width(m.skeleton.GetTerminalWidth() - 6).
+		MarginLeft(1)
+
+	if m.filterInput.Focused() || !m.filter.IsEmpty() {
+		style = style.BorderForeground(lipgloss.Color("39"))
+	}
+
+	return style.Render(m.filterInput.View())
+}
+
 func (m *ModelGithubWorkflowHistory) renderHelp() string {
 	helpStyle := WindowStyleHelp.Width(m.skeleton.GetTerminalWidth() - 4)
 	return helpStyle.Render(m.ViewHelp())
@@ -422,9 +835,8 @@ func (m *ModelGithubWorkflowHistory) renderHelp() string {
 
 func (m *ModelGithubWorkflowHistory) updateTableDimensions() {
 	const (
-		minTableWidth  = 80 // Minimum width to maintain readability
-		tablePadding   = 18 // Account for borders and margins
-		minColumnWidth = 10 // Minimum width for any column
+		minTableWidth = 80 // Minimum width to maintain readability
+		tablePadding  = 18 // Account for borders and margins
 	)
 
 	termWidth := m.skeleton.GetTerminalWidth()
@@ -434,26 +846,11 @@ func (m *ModelGithubWorkflowHistory) updateTableDimensions() {
 		return // Prevent table from becoming too narrow
 	}
 
-	var tableWidth int
-	for _, t := range tableColumnsWorkflowHistory {
-		tableWidth += t.Width
-	}
-
-	newTableColumns := make([]table.Column, len(tableColumnsWorkflowHistory))
-	copy(newTableColumns, tableColumnsWorkflowHistory)
-
-	widthDiff := termWidth - tableWidth - tablePadding
-	if widthDiff > 0 {
-		// Distribute extra width between workflow name and action name columns
-		extraWidth := widthDiff / 2
-		newTableColumns[0].Width = max(newTableColumns[0].Width+extraWidth, minColumnWidth)
-		newTableColumns[1].Width = max(newTableColumns[1].Width+extraWidth, minColumnWidth)
-
-		m.tableWorkflowHistory.SetColumns(newTableColumns)
-	}
+	// Extra width is shared by the flex columns, like the workflow name and the commit message
+	m.tableWorkflowHistory.SetColumns(fitColumns(m.columns, m.sort, termWidth-tablePadding))
 
-	// Ensure reasonable table height
-	maxHeight := termHeight - 17
+	// Ensure reasonable table height, the filter bar takes 3 lines
+	maxHeight := termHeight - 20
 	if maxHeight > 0 {
 		m.tableWorkflowHistory.SetHeight(maxHeight)
 	}
@@ -464,10 +861,17 @@ func (m *ModelGithubWorkflowHistory) updateTableDimensions() {
 // -----------------------------------------------------------------------------
 
 func (m *ModelGithubWorkflowHistory) setupOptions() {
-	m.modelTabOptions.AddOption("Open in browser", m.openInBrowser)
-	m.modelTabOptions.AddOption("Rerun failed jobs", m.rerunFailedJobs)
-	m.modelTabOptions.AddOption("Rerun workflow", m.rerunWorkflow)
-	m.modelTabOptions.AddOption("Cancel workflow", m.cancelWorkflow)
+	m.modelTabOptions.AddOption("Browse", m.openInBrowser)
+	m.modelTabOptions.AddOption("Rerun failed", m.rerunFailedJobs)
+	m.modelTabOptions.AddOption("Rerun all", m.rerunWorkflow)
+	m.modelTabOptions.AddOption("Cancel", m.cancelWorkflow)
+	m.modelTabOptions.AddOption("Force cancel", m.forceCancelWorkflow)
+	m.modelTabOptions.AddOption("Graph", m.viewRunGraph)
+	m.modelTabOptions.AddOption("Approve", m.approveDeployments)
+	m.modelTabOptions.AddOption("Reject", m.rejectDeployments)
+	m.modelTabOptions.AddToggle("Debug", m.debugLogging, m.toggleDebugLogging)
+	m.modelTabOptions.AddOption("Delete", m.viewDeleteRun)
+	m.modelTabOptions.AddKeyOption("x", "Cancel queued", m.cancelQueuedRuns)
 }
 
 func (m *ModelGithubWorkflowHistory) openInBrowser() {
@@ -485,19 +889,66 @@ func (m *ModelGithubWorkflowHistory) openInBrowser() {
 	m.status.SetSuccessMessage("Opened in browser")
 }
 
+// toggleDebugLogging switches the debug logs of the re-runs, it returns whether they are written
+func (m *ModelGithubWorkflowHistory) toggleDebugLogging() bool {
+	m.debugLogging = !m.debugLogging
+	return m.debugLogging
+}
+
+// debugLoggingNote is added to the messages of the re-runs which write debug logs
+func (m *ModelGithubWorkflowHistory) debugLoggingNote() string {
+	if m.debugLogging {
+		return " with debug logging"
+	}
+	return ""
+}
+
 func (m *ModelGithubWorkflowHistory) rerunFailedJobs() {
-	m.status.SetProgressMessage("Re-running failed jobs...")
+	if runs := m.markedWorkflows(); len(runs) > 0 {
+		m.rerunMarkedRuns(runs)
+		return
+	}
+
+	m.status.SetProgressMessage("Re-running failed jobs" + m.debugLoggingNote() + "...")
 
 	if err := m.github.ReRunFailedJobs(context.Background(), gu.ReRunFailedJobsInput{
-		Repository: m.selectedRepository.RepositoryName,
-		WorkflowID: m.selectedWorkflowID,
+		Repository:         m.selectedRepository.RepositoryName,
+		WorkflowID:         m.selectedWorkflowID,
+		EnableDebugLogging: m.debugLogging,
 	}); err != nil {
 		m.status.SetError(err)
 		m.status.SetErrorMessage("Failed to re-run failed jobs")
 		return
 	}
 
-	m.status.SetSuccessMessage("Re-ran failed jobs")
+	m.status.SetSuccessMessage("Re-ran failed jobs" + m.debugLoggingNote())
+}
+
+// setRerunJobConfirmation selects the job which is re-run if enter is pressed, nil clears it
+func (m *ModelGithubWorkflowHistory) setRerunJobConfirmation(job *gu.RunJob) {
+	m.rerunJobConfirmation = job
+	m.keys.ConfirmReRunJob.SetEnabled(job != nil)
+}
+
+// rerunJob re-runs the job selected in the graph of the run, the jobs which depend on it are re-run too
+func (m *ModelGithubWorkflowHistory) rerunJob(job gu.RunJob) {
+	defer m.skeleton.TriggerUpdate()
+
+	m.status.SetProgressMessage(fmt.Sprintf("Re-running job %s%s...", job.Name, m.debugLoggingNote()))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := m.github.ReRunJob(ctx, gu.ReRunJobInput{
+		Repository:         m.selectedRepository.RepositoryName,
+		JobID:              job.ID,
+		EnableDebugLogging: m.debugLogging,
+	}); err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage(fmt.Sprintf("Failed to re-run job %s: %v", job.Name, err))
+		return
+	}
+
+	m.status.SetSuccessMessage(fmt.Sprintf("Re-ran job %s%s", job.Name, m.debugLoggingNote()))
 }
 
 func (m *ModelGithubWorkflowHistory) rerunWorkflow() {
@@ -506,13 +957,14 @@ func (m *ModelGithubWorkflowHistory) rerunWorkflow() {
 		return
 	}
 
-	m.status.SetProgressMessage("Re-running workflow...")
+	m.status.SetProgressMessage("Re-running workflow" + m.debugLoggingNote() + "...")
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	if err := m.github.ReRunWorkflow(ctx, gu.ReRunWorkflowInput{
-		Repository: m.selectedRepository.RepositoryName,
-		WorkflowID: m.selectedWorkflowID,
+		Repository:         m.selectedRepository.RepositoryName,
+		WorkflowID:         m.selectedWorkflowID,
+		EnableDebugLogging: m.debugLogging,
 	}); err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			m.status.SetErrorMessage("Workflow re-run request timed out")
@@ -523,7 +975,7 @@ func (m *ModelGithubWorkflowHistory) rerunWorkflow() {
 		return
 	}
 
-	m.status.SetSuccessMessage("Workflow re-run initiated")
+	m.status.SetSuccessMessage("Workflow re-run initiated" + m.debugLoggingNote())
 	// Trigger refresh after short delay to show updated status
 	go func() {
 		time.Sleep(2 * time.Second)
@@ -532,16 +984,659 @@ func (m *ModelGithubWorkflowHistory) rerunWorkflow() {
 }
 
 func (m *ModelGithubWorkflowHistory) cancelWorkflow() {
-	m.status.SetProgressMessage("Canceling workflow...")
+	m.cancelRuns(false)
+}
+
+// forceCancelWorkflow cancels the run even if its steps run when it is cancelled, like the ones with always()
+func (m *ModelGithubWorkflowHistory) forceCancelWorkflow() {
+	m.cancelRuns(true)
+}
+
+func (m *ModelGithubWorkflowHistory) cancelRuns(force bool) {
+	action := "Cancel"
+	if force {
+		action = "Force-cancel"
+	}
+
+	if runs := m.markedWorkflows(); len(runs) > 0 {
+		m.cancelMarkedRuns(runs, force)
+		return
+	}
+
+	m.status.SetProgressMessage(action + "ing workflow...")
 
 	if err := m.github.CancelWorkflow(context.Background(), gu.CancelWorkflowInput{
 		Repository: m.selectedRepository.RepositoryName,
 		WorkflowID: m.selectedWorkflowID,
+		Force:      force,
 	}); err != nil {
 		m.status.SetError(err)
-		m.status.SetErrorMessage("Failed to cancel workflow")
+		m.status.SetErrorMessage(fmt.Sprintf("Failed to %s workflow", strings.ToLower(action)))
+		return
+	}
+
+	m.status.SetSuccessMessage(action + "ed workflow")
+}
+
+// -----------------------------------------------------------------------------
+// Bulk Actions
+// -----------------------------------------------------------------------------
+
+// runMarks are the IDs of the marked runs, they are read and changed by the options and the syncs in goroutines
+type runMarks struct {
+	mu   sync.Mutex
+	runs map[int64]bool
+}
+
+func newRunMarks() *runMarks {
+	return &runMarks{runs: make(map[int64]bool)}
+}
+
+func (r *runMarks) Has(runID int64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.runs[runID]
+}
+
+// Toggle marks the run or unmarks it, it returns whether the run is marked
+func (r *runMarks) Toggle(runID int64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.runs[runID] {
+		delete(r.runs, runID)
+		return false
+	}
+	r.runs[runID] = true
+	return true
+}
+
+func (r *runMarks) Clear() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clear(r.runs)
+}
+
+// Len returns the number of the marked runs
+func (r *runMarks) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.runs)
+}
+
+// Keep unmarks the runs which are not in the given runs
+func (r *runMarks) Keep(runs []gu.Workflow) {
+	listed := make(map[int64]bool, len(runs))
+	for _, run := range runs {
+		listed[run.ID] = true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for runID := range r.runs {
+		if !listed[runID] {
+			delete(r.runs, runID)
+		}
+	}
+}
+
+// toggleMark marks the run under the cursor or unmarks it, the cursor moves to the next run to mark runs quickly
+func (m *ModelGithubWorkflowHistory) toggleMark() {
+	if m.selectedWorkflowID == 0 {
+		m.status.SetErrorMessage("No workflow selected")
 		return
 	}
 
-	m.status.SetSuccessMessage("Canceled workflow")
+	m.marked.Toggle(m.selectedWorkflowID)
+	m.updateWorkflowTable()
+	m.tableWorkflowHistory.MoveDown(1)
+
+	visible := len(m.markedWorkflows())
+	if visible == 0 {
+		m.status.SetDefaultMessage("No runs marked")
+		return
+	}
+
+	message := fmt.Sprintf("%d runs marked, Rerun failed, Cancel and Force cancel act on them", visible)
+	if hidden := m.marked.Len() - visible; hidden > 0 {
+		message += fmt.Sprintf(", %d marked runs hidden by the filter are left out", hidden)
+	}
+	m.status.SetDefaultMessage(message)
+}
+
+func (m *ModelGithubWorkflowHistory) clearMarks() {
+	m.marked.Clear()
+	m.updateWorkflowTable()
+	m.status.SetDefaultMessage("No runs marked")
+}
+
+// pruneMarks unmarks the runs which are not listed anymore
+func (m *ModelGithubWorkflowHistory) pruneMarks() {
+	m.marked.Keep(m.workflows)
+}
+
+// markedWorkflows returns the marked runs of the table, the ones hidden by the filter are left out
+func (m *ModelGithubWorkflowHistory) markedWorkflows() []gu.Workflow {
+	var runs []gu.Workflow
+	for _, workflow := range m.visibleWorkflows {
+		if m.marked.Has(workflow.ID) {
+			runs = append(runs, workflow)
+		}
+	}
+	return runs
+}
+
+func (m *ModelGithubWorkflowHistory) rerunMarkedRuns(runs []gu.Workflow) {
+	m.status.SetProgressMessage(fmt.Sprintf("Re-running failed jobs of %d marked runs%s...", len(runs), m.debugLoggingNote()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	output, err := m.github.ReRunFailedWorkflowRuns(ctx, gu.ReRunFailedWorkflowRunsInput{
+		Repository:         m.selectedRepository.RepositoryName,
+		Runs:               runs,
+		EnableDebugLogging: m.debugLogging,
+	})
+	m.finishBulkAction("Re-ran failed jobs of", "didn't fail", output, err)
+}
+
+func (m *ModelGithubWorkflowHistory) cancelMarkedRuns(runs []gu.Workflow, force bool) {
+	action := "Cancel"
+	if force {
+		action = "Force-cancel"
+	}
+	m.status.SetProgressMessage(fmt.Sprintf("%sing %d marked runs...", action, len(runs)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	output, err := m.github.CancelWorkflowRuns(ctx, gu.CancelWorkflowRunsInput{
+		Repository: m.selectedRepository.RepositoryName,
+		Runs:       runs,
+		Force:      force,
+	})
+	m.finishBulkAction(action+"ed", "were completed", output, err)
+}
+
+// cancelQueuedRuns cancels the queued runs of the workflow of the run under the cursor, the queued runs which are
+// not listed are cancelled too
+func (m *ModelGithubWorkflowHistory) cancelQueuedRuns() {
+	defer m.skeleton.TriggerUpdate()
+
+	run, ok := m.selectedWorkflow()
+	if !ok {
+		m.status.SetErrorMessage("No workflow selected")
+		return
+	}
+
+	m.status.SetProgressMessage(fmt.Sprintf("Canceling the queued runs of %s...", run.WorkflowName))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	output, err := m.github.CancelQueuedRuns(ctx, gu.CancelQueuedRunsInput{
+		Repository: m.selectedRepository.RepositoryName,
+		WorkflowID: run.WorkflowID,
+	})
+	if err == nil && len(output.Runs) == 0 && len(output.Failures) == 0 {
+		m.status.SetDefaultMessage(fmt.Sprintf("%s has no queued runs", run.WorkflowName))
+		return
+	}
+	m.finishBulkAction("Canceled queued", "", output, err)
+}
+
+// finishBulkAction reports the runs of a bulk action and refreshes the history, skipped tells why runs were skipped
+func (m *ModelGithubWorkflowHistory) finishBulkAction(done, skipped string, output *gu.BulkRunsOutput, err error) {
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			m.status.SetErrorMessage("Bulk action timed out")
+		} else {
+			m.status.SetError(err)
+			m.status.SetErrorMessage(fmt.Sprintf("Bulk action failed: %v", err))
+		}
+		return
+	}
+
+	message := fmt.Sprintf("%s %d runs", done, len(output.Runs))
+	if output.Skipped > 0 {
+		message += fmt.Sprintf(", skipped %d which %s", output.Skipped, skipped)
+	}
+
+	if len(output.Failures) > 0 {
+		runNumbers := make(map[int64]int, len(m.workflows))
+		for _, workflow := range m.workflows {
+			runNumbers[workflow.ID] = workflow.RunNumber
+		}
+
+		var failures []string
+		for _, failure := range output.Failures {
+			run := fmt.Sprintf("run %d", failure.RunID)
+			if runNumber, ok := runNumbers[failure.RunID]; ok {
+				run = fmt.Sprintf("#%d", runNumber)
+			}
+			failures = append(failures, fmt.Sprintf("%s (%v)", run, failure.Err))
+		}
+		m.status.SetError(output.Failures[0].Err)
+		m.status.SetErrorMessage(message + ", failed: " + strings.Join(failures, ", "))
+	} else {
+		m.status.SetSuccessMessage(message)
+	}
+
+	m.marked.Clear()
+	// Trigger refresh after short delay to show updated status
+	go func() {
+		time.Sleep(2 * time.Second)
+		m.syncWorkflowHistory(m.syncWorkflowHistoryContext)
+	}()
+}
+
+func (m *ModelGithubWorkflowHistory) viewDeleteRun() {
+	defer m.skeleton.TriggerUpdate()
+
+	run, ok := m.selectedWorkflow()
+	switch {
+	case !ok:
+		m.status.SetErrorMessage("No workflow selected")
+		return
+	case run.Status != "completed":
+		m.status.SetErrorMessage(fmt.Sprintf("Run %d is %s, only completed runs and their logs can be deleted",
+			run.RunNumber, strings.ReplaceAll(run.Status, "_", " ")))
+		return
+	}
+
+	m.deleteRun.Open(run)
+	m.setDeleteKeys(true)
+	m.status.SetDefaultMessage(fmt.Sprintf("Type %d or logs to confirm the deletion", run.RunNumber))
+}
+
+// confirmDeleteRun deletes the run or its logs if the confirmation is typed
+func (m *ModelGithubWorkflowHistory) confirmDeleteRun() {
+	run := m.deleteRun.Run()
+	target := m.deleteRun.Target()
+	if target == deleteNothing {
+		m.status.SetErrorMessage(fmt.Sprintf("Type %d to delete the run or logs to delete its logs", run.RunNumber))
+		return
+	}
+	m.closeDeleteRun()
+
+	go func() {
+		defer m.skeleton.TriggerUpdate()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if target == deleteRunLogs {
+			m.status.SetProgressMessage(fmt.Sprintf("Deleting the logs of run %d...", run.RunNumber))
+			if err := m.github.DeleteWorkflowRunLogs(ctx, gu.DeleteWorkflowRunLogsInput{
+				Repository: m.selectedRepository.RepositoryName,
+				RunID:      run.ID,
+			}); err != nil {
+				m.status.SetError(err)
+				m.status.SetErrorMessage(fmt.Sprintf("Failed to delete the logs of run %d: %v", run.RunNumber, err))
+				return
+			}
+			m.status.SetSuccessMessage(fmt.Sprintf("Deleted the logs of run %d", run.RunNumber))
+			return
+		}
+
+		m.status.SetProgressMessage(fmt.Sprintf("Deleting run %d...", run.RunNumber))
+		if err := m.github.DeleteWorkflowRun(ctx, gu.DeleteWorkflowRunInput{
+			Repository: m.selectedRepository.RepositoryName,
+			RunID:      run.ID,
+		}); err != nil {
+			m.status.SetError(err)
+			m.status.SetErrorMessage(fmt.Sprintf("Failed to delete run %d: %v", run.RunNumber, err))
+			return
+		}
+		m.status.SetSuccessMessage(fmt.Sprintf("Deleted run %d and its logs", run.RunNumber))
+
+		// Trigger refresh after short delay to show updated status
+		time.Sleep(2 * time.Second)
+		m.syncWorkflowHistory(m.syncWorkflowHistoryContext)
+	}()
+}
+
+func (m *ModelGithubWorkflowHistory) closeDeleteRun() {
+	m.deleteRun.Close()
+	m.setDeleteKeys(false)
+}
+
+func (m *ModelGithubWorkflowHistory) viewRunGraph() {
+	if m.selectedWorkflowID == 0 {
+		m.status.SetErrorMessage("No workflow selected")
+		return
+	}
+
+	m.graphRunID = m.selectedWorkflowID
+	if m.refreshRunGraph() {
+		m.setGraphKeys(true)
+	}
+}
+
+// refreshRunGraph fetches the graph of the run and the states of its jobs, it reports whether the graph is shown
+func (m *ModelGithubWorkflowHistory) refreshRunGraph() bool {
+	m.status.SetProgressMessage(fmt.Sprintf("[%s] Fetching jobs of run %d...",
+		m.selectedRepository.RepositoryName, m.graphRunID))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	runGraph, err := m.github.GetWorkflowRunGraph(ctx, gu.GetWorkflowRunGraphInput{
+		Repository: m.selectedRepository.RepositoryName,
+		RunID:      m.graphRunID,
+	})
+	if err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage("Jobs graph of the run cannot be drawn")
+		return false
+	}
+
+	title := fmt.Sprintf("%s  %s@%.7s", runGraph.WorkflowName, runGraph.Path, runGraph.Ref)
+	if m.graphViewer.Visible() {
+		// Keep the scroll position while following the run
+		m.graphViewer.Refresh(title, runGraph.Graph, runGraph.JobStates)
+	} else {
+		m.graphViewer.Open(title, runGraph.Graph, runGraph.JobStates)
+	}
+	m.graphViewer.SetJobs(runGraph.Jobs)
+
+	m.status.SetSuccessMessage(fmt.Sprintf("[%s] Jobs of run %d fetched.",
+		m.selectedRepository.RepositoryName, m.graphRunID))
+	return true
+}
+
+func (m *ModelGithubWorkflowHistory) closeRunGraph() {
+	m.setRerunJobConfirmation(nil)
+	m.graphViewer.Close()
+	m.setGraphKeys(false)
+}
+
+func (m *ModelGithubWorkflowHistory) viewRunDetail() {
+	if m.selectedWorkflowID == 0 {
+		m.status.SetErrorMessage("No workflow selected")
+		return
+	}
+
+	runID := m.selectedWorkflowID
+	go func() {
+		if runDetail, ok := m.fetchRunDetail(runID, 0); ok {
+			m.runDetail.Open(runDetail)
+			m.setDetailKeys(true)
+		}
+	}()
+}
+
+// showRunAttempt replaces the detail with an attempt of the same run, it does nothing if the detail is closed
+// before the attempt is fetched
+func (m *ModelGithubWorkflowHistory) showRunAttempt(attempt int) {
+	runDetail, ok := m.fetchRunDetail(m.runDetail.Detail().ID, attempt)
+	if ok && m.runDetail.Visible() {
+		m.runDetail.Open(runDetail)
+	}
+}
+
+func (m *ModelGithubWorkflowHistory) fetchRunDetail(runID int64, attempt int) (gu.RunDetail, bool) {
+	defer m.skeleton.TriggerUpdate()
+
+	m.status.SetProgressMessage(fmt.Sprintf("[%s] Fetching details of run %d...",
+		m.selectedRepository.RepositoryName, runID))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	runDetail, err := m.github.GetWorkflowRunDetail(ctx, gu.GetWorkflowRunDetailInput{
+		Repository: m.selectedRepository.RepositoryName,
+		RunID:      runID,
+		Attempt:    attempt,
+	})
+	if err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage("Details of the run cannot be fetched")
+		return gu.RunDetail{}, false
+	}
+
+	m.status.SetSuccessMessage(fmt.Sprintf("[%s] Attempt %d of run %d fetched.",
+		m.selectedRepository.RepositoryName, runDetail.Attempt, runID))
+	return runDetail.RunDetail, true
+}
+
+func (m *ModelGithubWorkflowHistory) closeRunDetail() {
+	m.runDetail.Close()
+	m.setDetailKeys(false)
+}
+
+func (m *ModelGithubWorkflowHistory) viewWorkflowStatistics() {
+	cursor := m.tableWorkflowHistory.Cursor()
+	if cursor < 0 || cursor >= len(m.visibleWorkflows) {
+		m.status.SetErrorMessage("No workflow selected")
+		return
+	}
+
+	workflow := m.visibleWorkflows[cursor]
+	go func() {
+		if m.showWorkflowStatistics(workflow) {
+			m.setStatisticsKeys(true)
+		}
+	}()
+}
+
+// showWorkflowStatistics fetches the statistics of the workflow of the run, it reports whether they are shown
+func (m *ModelGithubWorkflowHistory) showWorkflowStatistics(workflow gu.Workflow) bool {
+	defer m.skeleton.TriggerUpdate()
+
+	m.status.SetProgressMessage(fmt.Sprintf("[%s] Fetching runs of %s for the statistics...",
+		m.selectedRepository.RepositoryName, workflow.WorkflowName))
+
+	// The runs of 90 days can take a few pages
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	statistics, err := m.github.GetWorkflowStatistics(ctx, gu.GetWorkflowStatisticsInput{
+		Repository: m.selectedRepository.RepositoryName,
+		WorkflowID: workflow.WorkflowID,
+	})
+	if err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage("Statistics of the workflow cannot be computed")
+		return false
+	}
+
+	m.statisticsWorkflow = workflow
+	m.statistics.Open(workflow.WorkflowName, statistics.Statistics)
+	m.status.SetSuccessMessage(fmt.Sprintf("[%s] Statistics of %s computed.",
+		m.selectedRepository.RepositoryName, workflow.WorkflowName))
+	return true
+}
+
+func (m *ModelGithubWorkflowHistory) closeWorkflowStatistics() {
+	m.statistics.Close()
+	m.setStatisticsKeys(false)
+}
+
+// selectedWorkflow returns the run under the cursor
+func (m *ModelGithubWorkflowHistory) selectedWorkflow() (gu.Workflow, bool) {
+	for _, workflow := range m.visibleWorkflows {
+		if workflow.ID == m.selectedWorkflowID {
+			return workflow, true
+		}
+	}
+	return gu.Workflow{}, false
+}
+
+func (m *ModelGithubWorkflowHistory) viewPendingDeployments() {
+	run, ok := m.selectedWorkflow()
+	switch {
+	case !ok:
+		m.status.SetErrorMessage("No workflow selected")
+		return
+	case run.Status != "waiting":
+		m.status.SetErrorMessage(fmt.Sprintf("Run %d is %s, only waiting runs have pending deployments",
+			run.RunNumber, strings.ReplaceAll(run.Status, "_", " ")))
+		return
+	}
+
+	go func() {
+		if m.showPendingDeployments(run) {
+			m.setPendingKeys(true)
+		}
+	}()
+}
+
+// showPendingDeployments fetches the deployments the run waits for, it reports whether they are shown
+func (m *ModelGithubWorkflowHistory) showPendingDeployments(run gu.Workflow) bool {
+	defer m.skeleton.TriggerUpdate()
+
+	m.status.SetProgressMessage(fmt.Sprintf("[%s] Fetching pending deployments of run %d...",
+		m.selectedRepository.RepositoryName, run.RunNumber))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	pendingDeployments, err := m.github.GetPendingDeployments(ctx, gu.GetPendingDeploymentsInput{
+		Repository: m.selectedRepository.RepositoryName,
+		RunID:      run.ID,
+	})
+	if err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage("Pending deployments of the run cannot be listed")
+		return false
+	}
+
+	m.pendingDeployments.Open(run, pendingDeployments.Deployments)
+	m.status.SetSuccessMessage(fmt.Sprintf("[%s] Run %d waits for %d deployments.",
+		m.selectedRepository.RepositoryName, run.RunNumber, len(pendingDeployments.Deployments)))
+	return true
+}
+
+func (m *ModelGithubWorkflowHistory) approveDeployments() {
+	m.reviewDeployments(true)
+}
+
+func (m *ModelGithubWorkflowHistory) rejectDeployments() {
+	m.reviewDeployments(false)
+}
+
+// reviewDeployments approves or rejects the deployments of the run of the pending deployments, or of the selected
+// run with the default comment if they are not open
+func (m *ModelGithubWorkflowHistory) reviewDeployments(approve bool) {
+	run, ok := m.selectedWorkflow()
+	var comment string
+	if m.pendingDeployments.Visible() {
+		run, ok, comment = m.pendingDeployments.Run(), true, m.pendingDeployments.Comment()
+	}
+	switch {
+	case !ok:
+		m.status.SetErrorMessage("No workflow selected")
+		return
+	case run.Status != "waiting":
+		m.status.SetErrorMessage(fmt.Sprintf("Run %d doesn't wait for a review", run.RunNumber))
+		return
+	}
+
+	action := "Rejected"
+	if approve {
+		action = "Approved"
+	}
+	m.status.SetProgressMessage(fmt.Sprintf("Reviewing the deployments of run %d...", run.RunNumber))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	review, err := m.github.ReviewPendingDeployments(ctx, gu.ReviewPendingDeploymentsInput{
+		Repository: m.selectedRepository.RepositoryName,
+		RunID:      run.ID,
+		Approve:    approve,
+		Comment:    comment,
+	})
+	if err != nil {
+		m.status.SetError(err)
+		m.status.SetErrorMessage(fmt.Sprintf("Deployments cannot be reviewed: %v", err))
+		return
+	}
+
+	m.closePendingDeployments()
+	m.status.SetSuccessMessage(fmt.Sprintf("%s the deployments of run %d to %s", action, run.RunNumber,
+		strings.Join(review.Environments, ", ")))
+
+	// Trigger refresh after short delay to show updated status
+	go func() {
+		time.Sleep(2 * time.Second)
+		m.syncWorkflowHistory(m.syncWorkflowHistoryContext)
+	}()
+}
+
+func (m *ModelGithubWorkflowHistory) closePendingDeployments() {
+	m.pendingDeployments.Close()
+	m.setCommentKeys(false)
+	m.setPendingKeys(false)
+}
+
+// setDeleteKeys shows the keys of the confirmation of a deletion in the help while it is open
+func (m *ModelGithubWorkflowHistory) setDeleteKeys(visible bool) {
+	m.keys.Filter.SetEnabled(!visible)
+	m.keys.ConfirmDelete.SetEnabled(visible)
+	m.keys.CloseDelete.SetEnabled(visible)
+	m.setTableKeys(!visible)
+}
+
+// setPendingKeys shows the keys of the pending deployments in the help while they are open
+func (m *ModelGithubWorkflowHistory) setPendingKeys(visible bool) {
+	m.keys.Filter.SetEnabled(!visible)
+	m.keys.EditComment.SetEnabled(visible)
+	m.keys.ClosePending.SetEnabled(visible)
+	m.setTableKeys(!visible)
+}
+
+// setCommentKeys shows the keys of the comment in the help while it is written
+func (m *ModelGithubWorkflowHistory) setCommentKeys(editing bool) {
+	m.keys.FinishComment.SetEnabled(editing)
+	m.keys.EditComment.SetEnabled(!editing && m.pendingDeployments.Visible())
+	m.keys.ClosePending.SetEnabled(!editing && m.pendingDeployments.Visible())
+}
+
+// setStatisticsKeys shows the keys of the statistics in the help while they are open
+func (m *ModelGithubWorkflowHistory) setStatisticsKeys(visible bool) {
+	m.keys.Filter.SetEnabled(!visible)
+	m.keys.PrevWindow.SetEnabled(visible)
+	m.keys.NextWindow.SetEnabled(visible)
+	m.keys.CloseStatistics.SetEnabled(visible)
+	m.setTableKeys(!visible)
+}
+
+// setDetailKeys shows the keys of the run detail in the help while it is open
+func (m *ModelGithubWorkflowHistory) setDetailKeys(visible bool) {
+	m.keys.Filter.SetEnabled(!visible)
+	m.keys.PrevAttempt.SetEnabled(visible)
+	m.keys.NextAttempt.SetEnabled(visible)
+	m.keys.CloseDetail.SetEnabled(visible)
+	m.setTableKeys(!visible)
+}
+
+// setFilterKeys shows the keys of the filter bar in the help while it is focused
+func (m *ModelGithubWorkflowHistory) setFilterKeys(focused bool) {
+	m.keys.Filter.SetEnabled(!focused)
+	m.keys.ApplyFilter.SetEnabled(focused)
+	m.keys.CloseFilter.SetEnabled(focused)
+	m.setTableKeys(!focused)
+}
+
+// setGraphKeys shows the keys of the graph viewer in the help while it is open
+func (m *ModelGithubWorkflowHistory) setGraphKeys(visible bool) {
+	m.keys.Filter.SetEnabled(!visible)
+	m.keys.ScrollGraph.SetEnabled(visible)
+	m.keys.NextJob.SetEnabled(visible)
+	m.keys.PrevJob.SetEnabled(visible)
+	m.keys.ReRunJob.SetEnabled(visible)
+	m.keys.CloseGraph.SetEnabled(visible)
+	m.setTableKeys(!visible)
+}
+
+func (m *ModelGithubWorkflowHistory) setTableKeys(enabled bool) {
+	m.keys.Detail.SetEnabled(enabled)
+	m.keys.Mark.SetEnabled(enabled)
+	m.keys.ClearMarks.SetEnabled(enabled)
+	m.keys.Statistics.SetEnabled(enabled)
+	m.keys.Pending.SetEnabled(enabled)
+	m.keys.SortBy.SetEnabled(enabled)
+	m.keys.SortOrder.SetEnabled(enabled)
+	m.keys.Columns.SetEnabled(enabled)
 }
@@ -20,19 +20,20 @@ func SetupTerminal(githubUseCase gu.UseCase, version pkgversion.Version) tea.Mod
 	s.AddPage("info", "Info", SetupModelInfo(s, githubUseCase, version))
 	s.AddPage("repository", "Repository", SetupModelGithubRepository(s, githubUseCase))
 	s.AddPage("history", "Workflow History", SetupModelGithubWorkflowHistory(s, githubUseCase))
+	s.AddPage("multihistory", "Multi-repo", SetupModelGithubMultiHistory(s, githubUseCase))
+	s.AddPage("usage", "Usage", SetupModelGithubUsage(s, githubUseCase))
 	s.AddPage("workflow", "Workflow", SetupModelGithubWorkflow(s, githubUseCase))
 	s.AddPage("trigger", "Trigger", SetupModelGithubTrigger(s, githubUseCase))
+	s.AddPage("dispatch", "Dispatch", SetupModelGithubDispatch(s, githubUseCase))
 
 	s.SetBorderColor("#ff0055").
 		SetActiveTabBorderColor("#ff0055").
 		SetInactiveTabBorderColor("#82636f").
-		SetWidgetBorderColor("#ff0055")
+		SetWidgetBorderColor("#ff0055").
+		SetTabLeftPadding(1). // the tabs fit in the minimum terminal width with narrower tabs
+		SetTabRightPadding(1)
 
-	if cfg.Settings.LiveMode.Enabled {
-		s.AddWidget("live", "Live Mode: On")
-	} else {
-		s.AddWidget("live", "Live Mode: Off")
-	}
+	s.AddWidget("live", liveModeWidget(NewLiveMode(s, cfg.Settings.LiveMode.Enabled).Enabled()))
 
 	s.SetTerminalViewportWidth(MinTerminalWidth)
 	s.SetTerminalViewportHeight(MinTerminalHeight)
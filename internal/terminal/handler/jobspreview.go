@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	pw "github.com/termkit/gama/pkg/workflow"
+	py "github.com/termkit/gama/pkg/yaml"
+	"github.com/termkit/skeleton"
+)
+
+// largeRunJobs is the number of jobs from which a run is highlighted as large before it is triggered
+const largeRunJobs = 20
+
+// ModelJobsPreview previews the jobs a run would start with the current input values and ref, the jobs skipped by
+// their if conditions are marked and the matrices of the jobs are expanded to their combinations
+type ModelJobsPreview struct {
+	skeleton *skeleton.Skeleton
+	viewport viewport.Model
+
+	visible bool
+	jobs    []py.WorkflowJob
+}
+
+func SetupModelJobsPreview(sk *skeleton.Skeleton) *ModelJobsPreview {
+	return &ModelJobsPreview{
+		skeleton: sk,
+		viewport: viewport.New(0, 0),
+	}
+}
+
+func (m *ModelJobsPreview) SetJobs(jobs []py.WorkflowJob) {
+	m.jobs = jobs
+	m.viewport.GotoTop()
+}
+
+func (m *ModelJobsPreview) Toggle() {
+	m.visible = !m.visible
+	m.viewport.GotoTop()
+}
+
+func (m *ModelJobsPreview) Close() {
+	m.visible = false
+}
+
+func (m *ModelJobsPreview) Visible() bool {
+	return m.visible
+}
+
+func (m *ModelJobsPreview) Update(msg tea.Msg) (*ModelJobsPreview, tea.Cmd) {
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// Summary renders a line with the number of jobs the run would start
+func (m *ModelJobsPreview) Summary(ctx pw.Context, previewKey string) string {
+	style := lipgloss.NewStyle().MarginLeft(2).MaxWidth(m.skeleton.GetTerminalWidth() - 4)
+	hintStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	skippedStyle := hintStyle.Strikethrough(true)
+	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+
+	if len(m.jobs) == 0 {
+		return style.Render(hintStyle.Render("Jobs: none found in the workflow file"))
+	}
+
+	previews := pw.PreviewJobs(m.jobs, ctx)
+	total, complete := countJobs(previews)
+
+	var jobs []string
+	var skipped int
+	var inputErr string
+	for _, preview := range previews {
+		switch {
+		case preview.Skipped:
+			jobs = append(jobs, skippedStyle.Render(preview.Job))
+			skipped++
+		case preview.Err != nil && preview.Count == 0:
+			jobs = append(jobs, hintStyle.Render(preview.Job+" ×?"))
+		case preview.Combinations != nil:
+			jobs = append(jobs, hintStyle.Render(fmt.Sprintf("%s ×%d", preview.Job, preview.Count)))
+		case preview.ConditionErr != nil:
+			jobs = append(jobs, hintStyle.Render(preview.Job+" if?"))
+		default:
+			jobs = append(jobs, hintStyle.Render(preview.Job))
+		}
+
+		if inputErr == "" {
+			if err := inputError(preview); err != nil {
+				inputErr = fmt.Sprintf("  %s: %v", preview.Job, err)
+			}
+		}
+	}
+
+	count := fmt.Sprintf("%d jobs", total)
+	if !complete {
+		count = "at least " + count
+	}
+	if skipped > 0 {
+		count += fmt.Sprintf(", %d skipped", skipped)
+	}
+
+	return style.Render(countStyle(previews, total).Render("Jobs: "+count) +
+		hintStyle.Render("  ") + strings.Join(jobs, hintStyle.Render(", ")) +
+		hintStyle.Render(fmt.Sprintf("  (%s to preview)", previewKey)) +
+		errorStyle.Render(inputErr))
+}
+
+// View renders the jobs and their matrix combinations in the given height
+func (m *ModelJobsPreview) View(ctx pw.Context, height int) string {
+	width := m.skeleton.GetTerminalWidth() - 4
+
+	// Borders and the header take 3 lines
+	m.viewport.Width = width - 2
+	m.viewport.Height = max(height-3, 1)
+
+	previews := pw.PreviewJobs(m.jobs, ctx)
+	total, complete := countJobs(previews)
+
+	jobStyle := lipgloss.NewStyle().Bold(true)
+	detailStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("250"))
+	hintStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	warningStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("208"))
+	lineStyle := lipgloss.NewStyle().MaxWidth(m.viewport.Width)
+
+	var lines []string
+	var skipped int
+	for _, preview := range previews {
+		if preview.Skipped {
+			skipped++
+			lines = append(lines, lineStyle.Render(jobStyle.Foreground(lipgloss.Color("240")).Strikethrough(true).Render(preview.Job)+
+				hintStyle.Render(" skipped, "+preview.SkipReason)))
+			continue
+		}
+
+		line := jobStyle.Render(preview.Job)
+		if preview.Combinations != nil {
+			line += hintStyle.Render(fmt.Sprintf(" ×%d", preview.Count))
+		}
+		if job := m.job(preview.Job); job.Uses != "" {
+			line += hintStyle.Render(" calls " + job.Uses + ", its jobs are not counted")
+		}
+		lines = append(lines, lineStyle.Render(line))
+
+		if preview.ConditionErr != nil {
+			lines = append(lines, lineStyle.Render(warningStyle.Render("  if cannot be evaluated, taken as true: "+
+				preview.ConditionErr.Error())))
+		}
+		if preview.Err != nil {
+			lines = append(lines, lineStyle.Render(errorStyle.Render("  "+preview.Err.Error())))
+		}
+		for _, combination := range preview.Combinations {
+			lines = append(lines, lineStyle.Render(detailStyle.Render("  "+combination.String())))
+		}
+	}
+	m.viewport.SetContent(strings.Join(lines, "\n"))
+
+	count := fmt.Sprintf("%d jobs would run", total)
+	if !complete {
+		count = fmt.Sprintf("at least %d jobs would run, some matrices cannot be expanded before the run", total)
+	}
+	if skipped > 0 {
+		count += fmt.Sprintf(", %d skipped", skipped)
+	}
+
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("#3b698f")).
+		Width(width - 2).
+		MarginLeft(1)
+
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left,
+		lineStyle.Render(countStyle(previews, total).Render(count)),
+		m.viewport.View()))
+}
+
+func (m *ModelJobsPreview) job(id string) py.WorkflowJob {
+	for _, job := range m.jobs {
+		if job.ID == id {
+			return job
+		}
+	}
+	return py.WorkflowJob{}
+}
+
+// inputError returns the error of a condition or a matrix which comes from the input values, like an input which is
+// not valid JSON for fromJSON, errors of values only known while the workflow runs are not input errors
+func inputError(preview pw.JobPreview) error {
+	for _, err := range []error{preview.ConditionErr, preview.Err} {
+		if err != nil && !errors.Is(err, pw.ErrUnavailable) && preview.Count <= pw.MaxMatrixJobs {
+			return err
+		}
+	}
+	return nil
+}
+
+// countJobs sums the jobs of the previews, it reports false if a matrix cannot be expanded
+func countJobs(previews []pw.JobPreview) (int, bool) {
+	var total int
+	var complete = true
+	for _, preview := range previews {
+		total += preview.Count
+		if preview.Err != nil && preview.Count == 0 {
+			complete = false
+		}
+	}
+	return total, complete
+}
+
+// countStyle highlights large runs and matrices GitHub would reject
+func countStyle(previews []pw.JobPreview, total int) lipgloss.Style {
+	for _, preview := range previews {
+		if preview.Count > pw.MaxMatrixJobs {
+			return lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Bold(true)
+		}
+	}
+	if total >= largeRunJobs {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("208")).Bold(true)
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("120"))
+}
@@ -84,16 +84,20 @@ func (m *ModelInfo) ViewHelp() string {
 type githubRepositoryKeyMap struct {
 	Refresh   teakey.Binding
 	SwitchTab teakey.Binding
+	SortBy    teakey.Binding
+	SortOrder teakey.Binding
+	Columns   teakey.Binding
 }
 
 func (k githubRepositoryKeyMap) ShortHelp() []teakey.Binding {
-	return []teakey.Binding{k.SwitchTab, k.Refresh}
+	return []teakey.Binding{k.SwitchTab, k.Refresh, k.SortBy, k.SortOrder, k.Columns}
 }
 
 func (k githubRepositoryKeyMap) FullHelp() [][]teakey.Binding {
 	return [][]teakey.Binding{
 		{k.SwitchTab},
 		{k.Refresh},
+		{k.SortBy, k.SortOrder, k.Columns},
 	}
 }
 
@@ -111,6 +115,18 @@ var githubRepositoryKeys = func() githubRepositoryKeyMap {
 			teakey.WithKeys(""), // help-only binding
 			teakey.WithHelp(tabSwitch, "switch tab"),
 		),
+		SortBy: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.SortBy),
+			teakey.WithHelp(cfg.Shortcuts.SortBy, "sort by"),
+		),
+		SortOrder: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.SortOrder),
+			teakey.WithHelp(cfg.Shortcuts.SortOrder, "reverse sort"),
+		),
+		Columns: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.Columns),
+			teakey.WithHelp(cfg.Shortcuts.Columns, "columns"),
+		),
 	}
 }()
 
@@ -121,13 +137,50 @@ func (m *ModelGithubRepository) ViewHelp() string {
 // ---------------------------------------------------------------------------
 
 type githubWorkflowHistoryKeyMap struct {
-	Refresh   teakey.Binding
-	SwitchTab teakey.Binding
-	LiveMode  teakey.Binding
+	Refresh     teakey.Binding
+	SwitchTab   teakey.Binding
+	LiveMode    teakey.Binding
+	Filter      teakey.Binding
+	ApplyFilter teakey.Binding
+	CloseFilter teakey.Binding
+	SortBy      teakey.Binding
+	SortOrder   teakey.Binding
+	Columns     teakey.Binding
+	Detail      teakey.Binding
+	PrevAttempt teakey.Binding
+	NextAttempt teakey.Binding
+	CloseDetail teakey.Binding
+	ScrollGraph teakey.Binding
+	CloseGraph  teakey.Binding
+	NextJob     teakey.Binding
+	PrevJob     teakey.Binding
+	ReRunJob    teakey.Binding
+
+	ConfirmReRunJob teakey.Binding
+
+	Statistics      teakey.Binding
+	PrevWindow      teakey.Binding
+	NextWindow      teakey.Binding
+	CloseStatistics teakey.Binding
+
+	Pending       teakey.Binding
+	EditComment   teakey.Binding
+	FinishComment teakey.Binding
+	ClosePending  teakey.Binding
+
+	Mark       teakey.Binding
+	ClearMarks teakey.Binding
+
+	ConfirmDelete teakey.Binding
+	CloseDelete   teakey.Binding
 }
 
 func (k githubWorkflowHistoryKeyMap) ShortHelp() []teakey.Binding {
-	return []teakey.Binding{k.SwitchTab, k.Refresh, k.LiveMode}
+	return []teakey.Binding{k.SwitchTab, k.Refresh, k.LiveMode, k.Filter, k.ApplyFilter, k.CloseFilter,
+		k.SortBy, k.SortOrder, k.Columns, k.Detail, k.PrevAttempt, k.NextAttempt, k.CloseDetail,
+		k.ScrollGraph, k.NextJob, k.PrevJob, k.ReRunJob, k.ConfirmReRunJob, k.CloseGraph, k.Statistics, k.PrevWindow, k.NextWindow, k.CloseStatistics,
+		k.Pending, k.EditComment, k.FinishComment, k.ClosePending, k.Mark, k.ClearMarks,
+		k.ConfirmDelete, k.CloseDelete}
 }
 
 func (k githubWorkflowHistoryKeyMap) FullHelp() [][]teakey.Binding {
@@ -135,6 +188,14 @@ func (k githubWorkflowHistoryKeyMap) FullHelp() [][]teakey.Binding {
 		{k.SwitchTab},
 		{k.Refresh},
 		{k.LiveMode},
+		{k.Filter, k.ApplyFilter, k.CloseFilter},
+		{k.SortBy, k.SortOrder, k.Columns},
+		{k.Detail, k.PrevAttempt, k.NextAttempt, k.CloseDetail},
+		{k.ScrollGraph, k.NextJob, k.PrevJob, k.ReRunJob, k.ConfirmReRunJob, k.CloseGraph},
+		{k.Statistics, k.PrevWindow, k.NextWindow, k.CloseStatistics},
+		{k.Pending, k.EditComment, k.FinishComment, k.ClosePending},
+		{k.Mark, k.ClearMarks},
+		{k.ConfirmDelete, k.CloseDelete},
 	}
 }
 
@@ -156,6 +217,137 @@ var githubWorkflowHistoryKeys = func() githubWorkflowHistoryKeyMap {
 			teakey.WithKeys(""), // help-only binding
 			teakey.WithHelp(tabSwitch, "switch tab"),
 		),
+		Filter: teakey.NewBinding(
+			teakey.WithKeys("/"),
+			teakey.WithHelp("/", "filter runs"),
+		),
+		ApplyFilter: teakey.NewBinding(
+			teakey.WithKeys("enter"),
+			teakey.WithHelp("enter", "apply filter"),
+			teakey.WithDisabled(),
+		),
+		CloseFilter: teakey.NewBinding(
+			teakey.WithKeys("esc"),
+			teakey.WithHelp("esc", "discard filter changes"),
+			teakey.WithDisabled(),
+		),
+		SortBy: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.SortBy),
+			teakey.WithHelp(cfg.Shortcuts.SortBy, "sort by"),
+		),
+		SortOrder: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.SortOrder),
+			teakey.WithHelp(cfg.Shortcuts.SortOrder, "reverse sort"),
+		),
+		Columns: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.Columns),
+			teakey.WithHelp(cfg.Shortcuts.Columns, "columns"),
+		),
+		Detail: teakey.NewBinding(
+			teakey.WithKeys("d"),
+			teakey.WithHelp("d", "run details"),
+		),
+		PrevAttempt: teakey.NewBinding(
+			teakey.WithKeys("left"),
+			teakey.WithHelp("←", "previous attempt"),
+			teakey.WithDisabled(),
+		),
+		NextAttempt: teakey.NewBinding(
+			teakey.WithKeys("right"),
+			teakey.WithHelp("→", "next attempt"),
+			teakey.WithDisabled(),
+		),
+		CloseDetail: teakey.NewBinding(
+			teakey.WithKeys("esc"),
+			teakey.WithHelp("esc", "close details"),
+			teakey.WithDisabled(),
+		),
+		ScrollGraph: teakey.NewBinding(
+			teakey.WithKeys(""), // help-only binding
+			teakey.WithHelp("↑/↓ ←/→", "scroll graph"),
+			teakey.WithDisabled(),
+		),
+		CloseGraph: teakey.NewBinding(
+			teakey.WithKeys("esc"),
+			teakey.WithHelp("esc", "close graph"),
+			teakey.WithDisabled(),
+		),
+		NextJob: teakey.NewBinding(
+			teakey.WithKeys("tab"),
+			teakey.WithHelp("tab", "next job"),
+			teakey.WithDisabled(),
+		),
+		PrevJob: teakey.NewBinding(
+			teakey.WithKeys("shift+tab"),
+			teakey.WithHelp("shift+tab", "previous job"),
+			teakey.WithDisabled(),
+		),
+		ReRunJob: teakey.NewBinding(
+			teakey.WithKeys("r"),
+			teakey.WithHelp("r", "re-run job"),
+			teakey.WithDisabled(),
+		),
+		ConfirmReRunJob: teakey.NewBinding(
+			teakey.WithKeys("enter"),
+			teakey.WithHelp("enter", "confirm re-run"),
+			teakey.WithDisabled(),
+		),
+		Statistics: teakey.NewBinding(
+			teakey.WithKeys("s"),
+			teakey.WithHelp("s", "workflow statistics"),
+		),
+		PrevWindow: teakey.NewBinding(
+			teakey.WithKeys("left"),
+			teakey.WithHelp("←", "shorter window"),
+			teakey.WithDisabled(),
+		),
+		NextWindow: teakey.NewBinding(
+			teakey.WithKeys("right"),
+			teakey.WithHelp("→", "longer window"),
+			teakey.WithDisabled(),
+		),
+		CloseStatistics: teakey.NewBinding(
+			teakey.WithKeys("esc"),
+			teakey.WithHelp("esc", "close statistics"),
+			teakey.WithDisabled(),
+		),
+		Pending: teakey.NewBinding(
+			teakey.WithKeys("p"),
+			teakey.WithHelp("p", "pending deployments"),
+		),
+		EditComment: teakey.NewBinding(
+			teakey.WithKeys("c"),
+			teakey.WithHelp("c", "write comment"),
+			teakey.WithDisabled(),
+		),
+		FinishComment: teakey.NewBinding(
+			teakey.WithKeys("enter", "esc"),
+			teakey.WithHelp("enter", "done"),
+			teakey.WithDisabled(),
+		),
+		ClosePending: teakey.NewBinding(
+			teakey.WithKeys("esc"),
+			teakey.WithHelp("esc", "close deployments"),
+			teakey.WithDisabled(),
+		),
+		Mark: teakey.NewBinding(
+			teakey.WithKeys(" "),
+			teakey.WithHelp("space", "mark run"),
+		),
+		ClearMarks: teakey.NewBinding(
+			teakey.WithKeys("u"),
+			teakey.WithHelp("u", "unmark runs"),
+		),
+		ConfirmDelete: teakey.NewBinding(
+			teakey.WithKeys("enter"),
+			teakey.WithHelp("enter", "delete"),
+			teakey.WithDisabled(),
+		),
+		CloseDelete: teakey.NewBinding(
+			teakey.WithKeys("esc"),
+			teakey.WithHelp("esc", "keep the run"),
+			teakey.WithDisabled(),
+		),
 	}
 }()
 
@@ -165,17 +357,146 @@ func (m *ModelGithubWorkflowHistory) ViewHelp() string {
 
 // ---------------------------------------------------------------------------
 
-type githubWorkflowKeyMap struct {
+type githubMultiHistoryKeyMap struct {
+	Refresh   teakey.Binding
+	SwitchTab teakey.Binding
+	LiveMode  teakey.Binding
+	SortBy    teakey.Binding
+	SortOrder teakey.Binding
+	Columns   teakey.Binding
+}
+
+func (k githubMultiHistoryKeyMap) ShortHelp() []teakey.Binding {
+	return []teakey.Binding{k.SwitchTab, k.Refresh, k.LiveMode, k.SortBy, k.SortOrder, k.Columns}
+}
+
+func (k githubMultiHistoryKeyMap) FullHelp() [][]teakey.Binding {
+	return [][]teakey.Binding{
+		{k.SwitchTab},
+		{k.Refresh},
+		{k.LiveMode},
+		{k.SortBy, k.SortOrder, k.Columns},
+	}
+}
+
+var githubMultiHistoryKeys = func() githubMultiHistoryKeyMap {
+	cfg := loadConfig()
+
+	var tabSwitch = fmt.Sprintf("%s | %s", cfg.Shortcuts.SwitchTabLeft, cfg.Shortcuts.SwitchTabRight)
+
+	return githubMultiHistoryKeyMap{
+		Refresh: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.Refresh),
+			teakey.WithHelp(cfg.Shortcuts.Refresh, "Refresh list"),
+		),
+		LiveMode: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.LiveMode),
+			teakey.WithHelp(cfg.Shortcuts.LiveMode, "Toggle live mode"),
+		),
+		SwitchTab: teakey.NewBinding(
+			teakey.WithKeys(""), // help-only binding
+			teakey.WithHelp(tabSwitch, "switch tab"),
+		),
+		SortBy: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.SortBy),
+			teakey.WithHelp(cfg.Shortcuts.SortBy, "sort by"),
+		),
+		SortOrder: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.SortOrder),
+			teakey.WithHelp(cfg.Shortcuts.SortOrder, "reverse sort"),
+		),
+		Columns: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.Columns),
+			teakey.WithHelp(cfg.Shortcuts.Columns, "columns"),
+		),
+	}
+}()
+
+func (m *ModelGithubMultiHistory) ViewHelp() string {
+	return m.Help.View(m.keys)
+}
+
+// ---------------------------------------------------------------------------
+
+type githubUsageKeyMap struct {
+	Refresh   teakey.Binding
 	SwitchTab teakey.Binding
+	SortBy    teakey.Binding
+	SortOrder teakey.Binding
+}
+
+func (k githubUsageKeyMap) ShortHelp() []teakey.Binding {
+	return []teakey.Binding{k.SwitchTab, k.Refresh, k.SortBy, k.SortOrder}
+}
+
+func (k githubUsageKeyMap) FullHelp() [][]teakey.Binding {
+	return [][]teakey.Binding{
+		{k.SwitchTab},
+		{k.Refresh},
+		{k.SortBy, k.SortOrder},
+	}
+}
+
+var githubUsageKeys = func() githubUsageKeyMap {
+	cfg := loadConfig()
+
+	var tabSwitch = fmt.Sprintf("%s | %s", cfg.Shortcuts.SwitchTabLeft, cfg.Shortcuts.SwitchTabRight)
+
+	return githubUsageKeyMap{
+		Refresh: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.Refresh),
+			teakey.WithHelp(cfg.Shortcuts.Refresh, "Refresh list"),
+		),
+		SwitchTab: teakey.NewBinding(
+			teakey.WithKeys(""), // help-only binding
+			teakey.WithHelp(tabSwitch, "switch tab"),
+		),
+		SortBy: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.SortBy),
+			teakey.WithHelp(cfg.Shortcuts.SortBy, "sort by"),
+		),
+		SortOrder: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.SortOrder),
+			teakey.WithHelp(cfg.Shortcuts.SortOrder, "reverse sort"),
+		),
+	}
+}()
+
+func (m *ModelGithubUsage) ViewHelp() string {
+	return m.Help.View(m.keys)
+}
+
+// ---------------------------------------------------------------------------
+
+type githubWorkflowKeyMap struct {
+	SwitchTab          teakey.Binding
+	SwitchRefMode      teakey.Binding
+	ToggleAllWorkflows teakey.Binding
+	SelectOption       teakey.Binding
+	NextSuggestion     teakey.Binding
+	PrevSuggestion     teakey.Binding
+	AcceptSuggestion   teakey.Binding
+	SortBy             teakey.Binding
+	SortOrder          teakey.Binding
+	ScrollFile         teakey.Binding
+	ToggleGraph        teakey.Binding
+	CloseFile          teakey.Binding
 }
 
 func (k githubWorkflowKeyMap) ShortHelp() []teakey.Binding {
-	return []teakey.Binding{k.SwitchTab}
+	return []teakey.Binding{k.SwitchTab, k.SwitchRefMode, k.ToggleAllWorkflows, k.SelectOption, k.NextSuggestion, k.PrevSuggestion, k.AcceptSuggestion,
+		k.SortBy, k.SortOrder, k.ScrollFile, k.ToggleGraph, k.CloseFile}
 }
 
 func (k githubWorkflowKeyMap) FullHelp() [][]teakey.Binding {
 	return [][]teakey.Binding{
 		{k.SwitchTab},
+		{k.SwitchRefMode},
+		{k.ToggleAllWorkflows},
+		{k.SelectOption},
+		{k.NextSuggestion, k.PrevSuggestion, k.AcceptSuggestion},
+		{k.SortBy, k.SortOrder},
+		{k.ScrollFile, k.ToggleGraph, k.CloseFile},
 	}
 }
 
@@ -189,6 +510,53 @@ var githubWorkflowKeys = func() githubWorkflowKeyMap {
 			teakey.WithKeys(""), // help-only binding
 			teakey.WithHelp(tabSwitch, "switch tab"),
 		),
+		SwitchRefMode: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.SwitchRefMode),
+			teakey.WithHelp(cfg.Shortcuts.SwitchRefMode, "branch | tag | ref"),
+		),
+		ToggleAllWorkflows: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.ToggleAllWorkflows),
+			teakey.WithHelp(cfg.Shortcuts.ToggleAllWorkflows, "all workflows"),
+		),
+		SelectOption: teakey.NewBinding(
+			teakey.WithKeys(""), // help-only binding, the options read the keys
+			teakey.WithHelp("alt+1…5", "select option"),
+		),
+		NextSuggestion: teakey.NewBinding(
+			teakey.WithKeys("ctrl+n"),
+			teakey.WithHelp("ctrl+n", "next suggestion"),
+		),
+		PrevSuggestion: teakey.NewBinding(
+			teakey.WithKeys("ctrl+p"),
+			teakey.WithHelp("ctrl+p", "previous suggestion"),
+		),
+		AcceptSuggestion: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.Tab),
+			teakey.WithHelp(cfg.Shortcuts.Tab, "accept suggestion"),
+		),
+		SortBy: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.SortBy),
+			teakey.WithHelp(cfg.Shortcuts.SortBy, "sort by"),
+		),
+		SortOrder: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.SortOrder),
+			teakey.WithHelp(cfg.Shortcuts.SortOrder, "reverse sort"),
+		),
+		ScrollFile: teakey.NewBinding(
+			teakey.WithKeys(""), // help-only binding
+			teakey.WithHelp("↑/↓ pgup/pgdn", "scroll file"),
+			teakey.WithDisabled(),
+		),
+		ToggleGraph: teakey.NewBinding(
+			teakey.WithKeys("g"),
+			teakey.WithHelp("g", "jobs graph"),
+			teakey.WithDisabled(),
+		),
+		CloseFile: teakey.NewBinding(
+			teakey.WithKeys("esc"),
+			teakey.WithHelp("esc", "close file"),
+			teakey.WithDisabled(),
+		),
 	}
 }()
 
@@ -198,15 +566,78 @@ func (m *ModelGithubWorkflow) ViewHelp() string {
 
 // ---------------------------------------------------------------------------
 
+type columnPickerKeyMap struct {
+	Up       teakey.Binding
+	Down     teakey.Binding
+	MoveUp   teakey.Binding
+	MoveDown teakey.Binding
+	Toggle   teakey.Binding
+	Save     teakey.Binding
+	Cancel   teakey.Binding
+}
+
+func (k columnPickerKeyMap) ShortHelp() []teakey.Binding {
+	return []teakey.Binding{k.Up, k.Down, k.MoveUp, k.MoveDown, k.Toggle, k.Save, k.Cancel}
+}
+
+func (k columnPickerKeyMap) FullHelp() [][]teakey.Binding {
+	return [][]teakey.Binding{
+		{k.Up, k.Down},
+		{k.MoveUp, k.MoveDown},
+		{k.Toggle},
+		{k.Save, k.Cancel},
+	}
+}
+
+var columnPickerKeys = func() columnPickerKeyMap {
+	cfg := loadConfig()
+
+	return columnPickerKeyMap{
+		Up: teakey.NewBinding(
+			teakey.WithKeys("up"),
+			teakey.WithHelp("↑", "up"),
+		),
+		Down: teakey.NewBinding(
+			teakey.WithKeys("down"),
+			teakey.WithHelp("↓", "down"),
+		),
+		MoveUp: teakey.NewBinding(
+			teakey.WithKeys("shift+up", "K"),
+			teakey.WithHelp("shift+↑", "move up"),
+		),
+		MoveDown: teakey.NewBinding(
+			teakey.WithKeys("shift+down", "J"),
+			teakey.WithHelp("shift+↓", "move down"),
+		),
+		Toggle: teakey.NewBinding(
+			teakey.WithKeys(" "),
+			teakey.WithHelp("space", "show | hide"),
+		),
+		Save: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.Enter),
+			teakey.WithHelp(cfg.Shortcuts.Enter, "save columns"),
+		),
+		Cancel: teakey.NewBinding(
+			teakey.WithKeys("esc"),
+			teakey.WithHelp("esc", "cancel"),
+		),
+	}
+}()
+
+// ---------------------------------------------------------------------------
+
 type githubTriggerKeyMap struct {
 	SwitchTabLeft teakey.Binding
 	SwitchTab     teakey.Binding
 	Trigger       teakey.Binding
 	Refresh       teakey.Binding
+	JobsPreview   teakey.Binding
+	AddJSON       teakey.Binding
+	RemoveJSON    teakey.Binding
 }
 
 func (k githubTriggerKeyMap) ShortHelp() []teakey.Binding {
-	return []teakey.Binding{k.SwitchTabLeft, k.Refresh, k.SwitchTab, k.Trigger}
+	return []teakey.Binding{k.SwitchTabLeft, k.Refresh, k.SwitchTab, k.Trigger, k.JobsPreview, k.AddJSON, k.RemoveJSON}
 }
 
 func (k githubTriggerKeyMap) FullHelp() [][]teakey.Binding {
@@ -215,6 +646,8 @@ func (k githubTriggerKeyMap) FullHelp() [][]teakey.Binding {
 		{k.Refresh},
 		{k.SwitchTab},
 		{k.Trigger},
+		{k.JobsPreview},
+		{k.AddJSON, k.RemoveJSON},
 	}
 }
 
@@ -240,9 +673,88 @@ var githubTriggerKeys = func() githubTriggerKeyMap {
 			teakey.WithKeys(cfg.Shortcuts.Enter),
 			teakey.WithHelp(cfg.Shortcuts.Enter, "trigger workflow"),
 		),
+		JobsPreview: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.JobsPreview),
+			teakey.WithHelp(cfg.Shortcuts.JobsPreview, "jobs preview"),
+		),
+		AddJSON: teakey.NewBinding(
+			teakey.WithKeys("alt+a"),
+			teakey.WithHelp("alt+a", "add json key/item"),
+		),
+		RemoveJSON: teakey.NewBinding(
+			teakey.WithKeys("alt+x"),
+			teakey.WithHelp("alt+x", "remove json key/item"),
+		),
 	}
 }()
 
 func (m *ModelGithubTrigger) ViewHelp() string {
 	return m.help.View(m.Keys)
 }
+
+// ---------------------------------------------------------------------------
+
+type githubDispatchKeyMap struct {
+	SwitchTabLeft    teakey.Binding
+	Refresh          teakey.Binding
+	SwitchFocus      teakey.Binding
+	NextSuggestion   teakey.Binding
+	PrevSuggestion   teakey.Binding
+	AcceptSuggestion teakey.Binding
+	Send             teakey.Binding
+}
+
+func (k githubDispatchKeyMap) ShortHelp() []teakey.Binding {
+	return []teakey.Binding{k.SwitchTabLeft, k.Refresh, k.SwitchFocus, k.NextSuggestion, k.PrevSuggestion, k.AcceptSuggestion, k.Send}
+}
+
+func (k githubDispatchKeyMap) FullHelp() [][]teakey.Binding {
+	return [][]teakey.Binding{
+		{k.SwitchTabLeft},
+		{k.Refresh},
+		{k.SwitchFocus},
+		{k.NextSuggestion, k.PrevSuggestion, k.AcceptSuggestion},
+		{k.Send},
+	}
+}
+
+var githubDispatchKeys = func() githubDispatchKeyMap {
+	cfg := loadConfig()
+
+	previousTab := cfg.Shortcuts.SwitchTabLeft
+
+	return githubDispatchKeyMap{
+		SwitchTabLeft: teakey.NewBinding(
+			teakey.WithKeys(""), // help-only binding
+			teakey.WithHelp(previousTab, "previous tab"),
+		),
+		Refresh: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.Refresh),
+			teakey.WithHelp(cfg.Shortcuts.Refresh, "Refresh event types"),
+		),
+		SwitchFocus: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.Tab),
+			teakey.WithHelp(cfg.Shortcuts.Tab, "event | payload | send"),
+		),
+		NextSuggestion: teakey.NewBinding(
+			teakey.WithKeys("down", "ctrl+n"),
+			teakey.WithHelp("↓", "next event type"),
+		),
+		PrevSuggestion: teakey.NewBinding(
+			teakey.WithKeys("up", "ctrl+p"),
+			teakey.WithHelp("↑", "previous event type"),
+		),
+		AcceptSuggestion: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.Enter),
+			teakey.WithHelp(cfg.Shortcuts.Enter, "select event type"),
+		),
+		Send: teakey.NewBinding(
+			teakey.WithKeys(cfg.Shortcuts.Enter),
+			teakey.WithHelp(cfg.Shortcuts.Enter, "send event"),
+		),
+	}
+}()
+
+func (m *ModelGithubDispatch) ViewHelp() string {
+	return m.help.View(m.keys)
+}
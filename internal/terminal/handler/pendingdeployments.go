@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gu "github.com/termkit/gama/internal/github/usecase"
+	"github.com/termkit/skeleton"
+)
+
+// ModelPendingDeployments shows the deployments a waiting run waits for and the comment of their review
+type ModelPendingDeployments struct {
+	skeleton *skeleton.Skeleton
+
+	visible      bool
+	run          gu.Workflow
+	deployments  []gu.PendingDeployment
+	commentInput textinput.Model
+}
+
+func SetupModelPendingDeployments(sk *skeleton.Skeleton) *ModelPendingDeployments {
+	ti := textinput.New()
+	ti.Blur()
+	ti.CharLimit = 512
+	ti.Placeholder = "Press c to write a comment for the review"
+	ti.ShowSuggestions = false
+
+	return &ModelPendingDeployments{
+		skeleton:     sk,
+		commentInput: ti,
+	}
+}
+
+// Open shows the deployments of the run, the comment is cleared if the run is another one
+func (m *ModelPendingDeployments) Open(run gu.Workflow, deployments []gu.PendingDeployment) {
+	if run.ID != m.run.ID {
+		m.commentInput.SetValue("")
+	}
+	m.run = run
+	m.deployments = deployments
+	m.visible = true
+}
+
+func (m *ModelPendingDeployments) Close() {
+	m.commentInput.Blur()
+	m.visible = false
+}
+
+func (m *ModelPendingDeployments) Visible() bool {
+	return m.visible
+}
+
+// Run returns the run whose deployments are shown
+func (m *ModelPendingDeployments) Run() gu.Workflow {
+	return m.run
+}
+
+func (m *ModelPendingDeployments) Comment() string {
+	return m.commentInput.Value()
+}
+
+func (m *ModelPendingDeployments) EditingComment() bool {
+	return m.commentInput.Focused()
+}
+
+func (m *ModelPendingDeployments) EditComment() tea.Cmd {
+	return m.commentInput.Focus()
+}
+
+func (m *ModelPendingDeployments) FinishComment() {
+	m.commentInput.Blur()
+}
+
+func (m *ModelPendingDeployments) UpdateComment(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	m.commentInput, cmd = m.commentInput.Update(msg)
+	return cmd
+}
+
+// View renders the deployments in the given height
+func (m *ModelPendingDeployments) View(height int) string {
+	width := m.skeleton.GetTerminalWidth() - 4
+
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Width(18)
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	approveStyle := lipgloss.NewStyle().Foreground(jobStateColors["success"])
+	waitingStyle := lipgloss.NewStyle().Foreground(jobStateColors["action_required"])
+
+	title := lipgloss.NewStyle().Foreground(lipgloss.Color("120")).Render(fmt.Sprintf("%s #%d", m.run.WorkflowName, m.run.RunNumber)) +
+		"  " + waitingStyle.Render("◆ waiting for a review") + mutedStyle.Render("  "+m.run.ActionName)
+
+	var lines []string
+	if len(m.deployments) == 0 {
+		lines = append(lines, mutedStyle.Render("The run has no deployments waiting for a review"))
+	}
+	for _, deployment := range m.deployments {
+		review := mutedStyle.Render("you are not a reviewer")
+		if deployment.CanApprove {
+			review = approveStyle.Render("you can review")
+		}
+
+		reviewers := "anyone with write access"
+		if len(deployment.Reviewers) > 0 {
+			reviewers = strings.Join(deployment.Reviewers, ", ")
+		}
+
+		line := labelStyle.Render(deployment.Environment) + review + mutedStyle.Render("  reviewers: "+reviewers)
+		if deployment.WaitUntil != "" {
+			line += mutedStyle.Render(fmt.Sprintf("  wait timer of %d min until %s", deployment.WaitTimer, deployment.WaitUntil))
+		}
+		lines = append(lines, line)
+	}
+
+	footer := mutedStyle.Render("Approve or reject the environments you can review with the options below")
+
+	// Borders, the title, the comment, the footer and the blank lines take 8 lines
+	if maxLines := max(height-8, 1); len(lines) > maxLines {
+		lines = lines[:maxLines]
+	}
+
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("#3b698f")).
+		Width(width - 2).
+		MarginLeft(1)
+
+	lineStyle := lipgloss.NewStyle().MaxWidth(width - 2)
+	content := []string{lineStyle.Render(title), ""}
+	for _, line := range lines {
+		content = append(content, lineStyle.Render(line))
+	}
+
+	m.commentInput.Width = max(width-labelStyle.GetWidth()-6, 10)
+	content = append(content, "",
+		lineStyle.Render(labelStyle.Render("Comment")+m.commentInput.View()),
+		"", lineStyle.Render(footer))
+
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, content...))
+}
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	gu "github.com/termkit/gama/internal/github/usecase"
+	"github.com/termkit/skeleton"
+)
+
+// maxDetailPullRequests is the number of pull requests listed in the detail of a run
+const maxDetailPullRequests = 3
+
+// ModelRunDetail shows the commit, the event, the actors and the timestamps of an attempt of a workflow run
+type ModelRunDetail struct {
+	skeleton *skeleton.Skeleton
+
+	visible bool
+	detail  gu.RunDetail
+}
+
+func SetupModelRunDetail(sk *skeleton.Skeleton) *ModelRunDetail {
+	return &ModelRunDetail{
+		skeleton: sk,
+	}
+}
+
+// Open shows the detail, it replaces the detail if it is already open
+func (m *ModelRunDetail) Open(detail gu.RunDetail) {
+	m.detail = detail
+	m.visible = true
+}
+
+func (m *ModelRunDetail) Close() {
+	m.visible = false
+}
+
+func (m *ModelRunDetail) Visible() bool {
+	return m.visible
+}
+
+// Detail returns the shown detail
+func (m *ModelRunDetail) Detail() gu.RunDetail {
+	return m.detail
+}
+
+// View renders the detail in the given height
+func (m *ModelRunDetail) View(height int) string {
+	width := m.skeleton.GetTerminalWidth() - 4
+	detail := m.detail
+
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Width(14)
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	highlightStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("208"))
+
+	state := detail.Status
+	if detail.Conclusion != "" {
+		state = detail.Conclusion
+	}
+	color, ok := jobStateColors[state]
+	if !ok {
+		color = "252"
+	}
+
+	title := lipgloss.NewStyle().Foreground(lipgloss.Color("120")).Render(fmt.Sprintf("%s #%d", detail.WorkflowName, detail.RunNumber)) +
+		"  " + lipgloss.NewStyle().Foreground(color).Render("● "+strings.ReplaceAll(state, "_", " ")) +
+		mutedStyle.Render(fmt.Sprintf("  attempt %d of %d", detail.Attempt, detail.Attempts))
+
+	var lines []string
+	field := func(label, value string) {
+		lines = append(lines, labelStyle.Render(label)+value)
+	}
+
+	field("Workflow file", detail.Path)
+	field("Event", detail.Event)
+	field("Branch", detail.HeadBranch)
+	commit := shortSHA(detail.HeadSHA) + " " + detail.CommitMessage
+	if detail.CommitAuthor != "" {
+		commit += mutedStyle.Render(" by " + detail.CommitAuthor)
+	}
+	field("Commit", commit)
+
+	field("Triggered by", detail.Actor)
+	switch {
+	case detail.TriggeringActor == "" || detail.TriggeringActor == detail.Actor:
+		field("Attempt by", detail.Actor)
+	default:
+		// A re-run by someone else than the one who triggered the run
+		field("Attempt by", highlightStyle.Render(detail.TriggeringActor))
+	}
+
+	switch {
+	case len(detail.PullRequests) > 0:
+		for i, pullRequest := range detail.PullRequests[:min(len(detail.PullRequests), maxDetailPullRequests)] {
+			label := ""
+			if i == 0 {
+				label = "Pull requests"
+			}
+			field(label, fmt.Sprintf("#%d %s → %s  %s", pullRequest.Number, pullRequest.HeadRef, pullRequest.BaseRef,
+				mutedStyle.Render(pullRequest.URL)))
+		}
+		if more := len(detail.PullRequests) - maxDetailPullRequests; more > 0 {
+			field("", mutedStyle.Render(fmt.Sprintf("and %d more", more)))
+		}
+	case strings.HasPrefix(detail.Event, "pull_request"):
+		field("Pull requests", mutedStyle.Render("not linked, the pull request may come from a fork"))
+	}
+
+	field("Queued", detail.QueuedAt)
+	field("Started", detail.StartedAt)
+	if detail.FinishedAt != "" {
+		field("Finished", detail.FinishedAt)
+	} else {
+		field("Finished", mutedStyle.Render("not finished yet"))
+	}
+	field("Duration", detail.Duration)
+
+	switch {
+	case detail.RunDuration == "" && len(detail.Billable) == 0:
+		field("Billable", mutedStyle.Render("not reported by GitHub"))
+	case len(detail.Billable) == 0:
+		field("Billable", mutedStyle.Render("nothing billed, the jobs may run on self-hosted runners"))
+	default:
+		var billable []string
+		for _, billableTime := range detail.Billable {
+			minutes := fmt.Sprintf("%d jobs, %d min", billableTime.Jobs, billableTime.Minutes)
+			if billableTime.Multiplier > 1 {
+				minutes += fmt.Sprintf(" ×%d", billableTime.Multiplier)
+			}
+			billable = append(billable, fmt.Sprintf("%s %s %s", billableTime.OS, billableTime.Duration.Round(time.Second),
+				mutedStyle.Render("("+minutes+")")))
+		}
+		field("Billable", strings.Join(billable, "  "))
+	}
+	if detail.RunDuration != "" {
+		field("Run duration", detail.RunDuration+mutedStyle.Render(" all attempts"))
+	}
+	field("URL", mutedStyle.Render(detail.URL))
+
+	footer := mutedStyle.Render("This run has a single attempt")
+	if detail.Attempts > 1 {
+		footer = mutedStyle.Render(fmt.Sprintf("←/→ to switch between the %d attempts of the run", detail.Attempts))
+	}
+
+	// Borders, the title, the footer and the blank lines take 6 lines
+	if maxLines := max(height-6, 1); len(lines) > maxLines {
+		lines = lines[:maxLines]
+	}
+
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("#3b698f")).
+		Width(width - 2).
+		MarginLeft(1)
+
+	lineStyle := lipgloss.NewStyle().MaxWidth(width - 2)
+	content := []string{lineStyle.Render(title), ""}
+	for _, line := range lines {
+		content = append(content, lineStyle.Render(line))
+	}
+	content = append(content, "", lineStyle.Render(footer))
+
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, content...))
+}
@@ -1,12 +1,228 @@
 package handler
 
-import "github.com/charmbracelet/bubbles/table"
+import (
+	"cmp"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
-var tableColumnsGithubRepository = []table.Column{
-	{Title: "Repository", Width: 24},
-	{Title: "Default Branch", Width: 16},
-	{Title: "Stars", Width: 6},
-	{Title: "Workflows", Width: 9},
+	"github.com/charmbracelet/bubbles/table"
+	gu "github.com/termkit/gama/internal/github/usecase"
+)
+
+// tableColumn is a column a table can be configured to show, key is its name in settings.columns of the config
+type tableColumn[T any] struct {
+	key      string
+	title    string
+	width    int
+	flex     bool // the column takes a share of the extra width of the terminal
+	optional bool // the column is shown only if it is configured
+	value    func(T) string
+}
+
+// selectColumns returns the columns of the keys in their order, unknown keys are skipped. The columns which are not
+// optional are returned if none of the keys is known.
+func selectColumns[T any](available []tableColumn[T], keys []string) []tableColumn[T] {
+	var columns []tableColumn[T]
+	for _, key := range keys {
+		for _, column := range available {
+			if column.key == strings.ToLower(strings.TrimSpace(key)) {
+				columns = append(columns, column)
+				break
+			}
+		}
+	}
+	if len(columns) > 0 {
+		return columns
+	}
+
+	for _, column := range available {
+		if !column.optional {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// columnKeys returns the keys of the columns in their order
+func columnKeys[T any](columns []tableColumn[T]) []string {
+	keys := make([]string, 0, len(columns))
+	for _, column := range columns {
+		keys = append(keys, column.key)
+	}
+	return keys
+}
+
+// columnChoices returns the available columns as the choices of the column picker, the shown ones come first in
+// their order
+func columnChoices[T any](available, shown []tableColumn[T]) []columnChoice {
+	var choices []columnChoice
+	for _, column := range shown {
+		choices = append(choices, columnChoice{key: column.key, title: column.title, shown: true})
+	}
+	for _, column := range available {
+		if !slices.ContainsFunc(shown, func(c tableColumn[T]) bool { return c.key == column.key }) {
+			choices = append(choices, columnChoice{key: column.key, title: column.title})
+		}
+	}
+	return choices
+}
+
+// fitColumns returns the table columns fitted into the width, the extra width is shared by the flex columns. The
+// title of the sorted column shows the direction of the sort.
+func fitColumns[T any](columns []tableColumn[T], sort tableSort, width int) []table.Column {
+	var tableWidth, flexColumns int
+	for _, column := range columns {
+		tableWidth += column.width
+		if column.flex {
+			flexColumns++
+		}
+	}
+
+	extraWidth := max(width-tableWidth, 0)
+	fitted := make([]table.Column, 0, len(columns))
+	for i, column := range columns {
+		c := table.Column{Title: sort.title(i, column.title), Width: column.width}
+		switch {
+		case flexColumns > 0 && column.flex:
+			c.Width += extraWidth / flexColumns
+		case flexColumns == 0 && i == 0:
+			c.Width += extraWidth
+		}
+		fitted = append(fitted, c)
+	}
+	return fitted
+}
+
+// columnRows returns the rows of the items
+func columnRows[T any](columns []tableColumn[T], items []T) []table.Row {
+	rows := make([]table.Row, 0, len(items))
+	for _, item := range items {
+		row := make(table.Row, 0, len(columns))
+		for _, column := range columns {
+			row = append(row, column.value(item))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// ---------------------------------------------------------------------------
+
+// tableSort is the column the rows of a table are sorted by, the rows keep their order while column is -1
+type tableSort struct {
+	column     int
+	descending bool
+}
+
+func newTableSort() tableSort {
+	return tableSort{column: -1}
+}
+
+// next sorts by the next column, it goes back to the order of the rows after the last column
+func (s *tableSort) next(columns int) {
+	s.column++
+	if s.column >= columns {
+		s.column = -1
+	}
+}
+
+func (s *tableSort) reverse() {
+	s.descending = !s.descending
+}
+
+func (s tableSort) title(column int, title string) string {
+	if column != s.column {
+		return title
+	}
+	if s.descending {
+		return title + " ▼"
+	}
+	return title + " ▲"
+}
+
+// describe returns the sort as a status message
+func (s tableSort) describe(columns []table.Column) string {
+	if s.column < 0 || s.column >= len(columns) {
+		return "Rows are not sorted"
+	}
+	direction := "ascending"
+	if s.descending {
+		direction = "descending"
+	}
+	return "Sorted by " + strings.TrimSuffix(strings.TrimSuffix(columns[s.column].Title, " ▲"), " ▼") + ", " + direction
+}
+
+// sortTable sorts the rows by the column of the sort, items are the values of the rows and are sorted with them
+func sortTable[T any](s tableSort, rows []table.Row, items []T) {
+	if s.column < 0 || len(rows) == 0 || s.column >= len(rows[0]) {
+		return
+	}
+
+	order := make([]int, len(rows))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		c := compareCells(rows[a][s.column], rows[b][s.column])
+		if s.descending {
+			return -c
+		}
+		return c
+	})
+
+	sortedRows := make([]table.Row, len(rows))
+	sortedItems := make([]T, len(items))
+	for i, index := range order {
+		sortedRows[i] = rows[index]
+		if index < len(items) {
+			sortedItems[i] = items[index]
+		}
+	}
+	copy(rows, sortedRows)
+	copy(items, sortedItems)
+}
+
+// compareCells compares numbers and durations by their values and everything else as case-insensitive text,
+// dates are written as 2006-01-02 15:04:05 so they are ordered as text
+func compareCells(a, b string) int {
+	if x, err := strconv.ParseFloat(a, 64); err == nil {
+		if y, err := strconv.ParseFloat(b, 64); err == nil {
+			return cmp.Compare(x, y)
+		}
+	}
+	if x, err := time.ParseDuration(strings.ReplaceAll(a, " ", "")); err == nil {
+		if y, err := time.ParseDuration(strings.ReplaceAll(b, " ", "")); err == nil {
+			return cmp.Compare(x, y)
+		}
+	}
+	return cmp.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// ---------------------------------------------------------------------------
+
+var repositoryColumns = []tableColumn[gu.GithubRepository]{
+	{key: "repository", title: "Repository", width: 24, flex: true,
+		value: func(r gu.GithubRepository) string { return r.Name }},
+	{key: "default_branch", title: "Default Branch", width: 16,
+		value: func(r gu.GithubRepository) string { return r.DefaultBranch }},
+	{key: "stars", title: "Stars", width: 7,
+		value: func(r gu.GithubRepository) string { return strconv.Itoa(r.Stars) }},
+	{key: "workflows", title: "Workflows", width: 11,
+		value: func(r gu.GithubRepository) string { return strconv.Itoa(len(r.Workflows)) }},
+	{key: "visibility", title: "Visibility", width: 10, optional: true,
+		value: func(r gu.GithubRepository) string {
+			if r.Private {
+				return "private"
+			}
+			return "public"
+		}},
+	{key: "updated", title: "Last Updated", width: 19, optional: true,
+		value: func(r gu.GithubRepository) string {
+			return r.LastUpdated.In(time.Local).Format("2006-01-02 15:04:05")
+		}},
 }
 
 // ---------------------------------------------------------------------------
@@ -23,16 +239,81 @@ var tableColumnsTrigger = []table.Column{
 
 var tableColumnsWorkflow = []table.Column{
 	{Title: "Workflow", Width: 32},
-	{Title: "File", Width: 48},
+	{Title: "File", Width: 40},
+	{Title: "State", Width: 20},
+}
+
+var tableColumnsAllWorkflows = []table.Column{
+	{Title: "Workflow", Width: 24},
+	{Title: "File", Width: 28},
+	{Title: "State", Width: 20},
+	{Title: "Triggers", Width: 28},
 }
 
 // ---------------------------------------------------------------------------
 
-var tableColumnsWorkflowHistory = []table.Column{
-	{Title: "Workflow", Width: 12},
-	{Title: "Commit Message", Width: 16},
-	{Title: "Triggered", Width: 12},
-	{Title: "Started At", Width: 19},
-	{Title: "Status", Width: 9},
-	{Title: "Duration", Width: 8},
+var workflowHistoryColumns = []tableColumn[gu.Workflow]{
+	{key: "repository", title: "Repository", width: 16, flex: true, optional: true,
+		value: func(w gu.Workflow) string { return w.Repository }},
+	{key: "workflow", title: "Workflow", width: 12, flex: true,
+		value: func(w gu.Workflow) string { return w.WorkflowName }},
+	{key: "title", title: "Commit Message", width: 16, flex: true,
+		value: func(w gu.Workflow) string { return w.ActionName }},
+	{key: "actor", title: "Triggered", width: 12,
+		value: func(w gu.Workflow) string { return w.TriggeredBy }},
+	{key: "started", title: "Started At", width: 19,
+		value: func(w gu.Workflow) string { return w.StartedAt }},
+	{key: "status", title: "Status", width: 9,
+		value: func(w gu.Workflow) string {
+			if w.Status == "waiting" {
+				// The badge marks the runs which may wait for a review of their deployments
+				return "◆ waiting"
+			}
+			return w.Status
+		}},
+	{key: "duration", title: "Duration", width: 10,
+		value: func(w gu.Workflow) string { return w.Duration }},
+	{key: "event", title: "Event", width: 12, optional: true,
+		value: func(w gu.Workflow) string { return w.Event }},
+	{key: "sha", title: "Head SHA", width: 8, optional: true,
+		value: func(w gu.Workflow) string { return shortSHA(w.HeadSHA) }},
+	{key: "number", title: "Run", width: 7, optional: true,
+		value: func(w gu.Workflow) string { return strconv.Itoa(w.RunNumber) }},
+	{key: "attempt", title: "Attempt", width: 9, optional: true,
+		value: func(w gu.Workflow) string { return strconv.Itoa(w.RunAttempt) }},
+	{key: "branch", title: "Branch", width: 16, flex: true, optional: true,
+		value: func(w gu.Workflow) string { return w.Branch }},
+	{key: "triggering_actor", title: "Attempt By", width: 12, optional: true,
+		value: func(w gu.Workflow) string { return w.TriggeringActor }},
+	{key: "queued", title: "Queued At", width: 19, optional: true,
+		value: func(w gu.Workflow) string { return w.QueuedAt }},
+}
+
+// usageColumns are the columns of the usage table, the durations are the billable times of the runners
+var usageColumns = []tableColumn[gu.WorkflowUsage]{
+	{key: "workflow", title: "Workflow", width: 16, flex: true,
+		value: func(u gu.WorkflowUsage) string { return u.Name }},
+	{key: "file", title: "File", width: 20, flex: true,
+		value: func(u gu.WorkflowUsage) string { return path.Base(u.Path) }},
+	{key: "ubuntu", title: "Ubuntu", width: 9,
+		value: func(u gu.WorkflowUsage) string { return u.Ubuntu.Round(time.Second).String() }},
+	{key: "windows", title: "Windows", width: 9,
+		value: func(u gu.WorkflowUsage) string { return u.Windows.Round(time.Second).String() }},
+	{key: "macos", title: "macOS", width: 9,
+		value: func(u gu.WorkflowUsage) string { return u.MacOS.Round(time.Second).String() }},
+	{key: "minutes", title: "Minutes", width: 9,
+		value: func(u gu.WorkflowUsage) string { return strconv.Itoa(u.Minutes) }},
+	{key: "share", title: "Share %", width: 9,
+		value: func(u gu.WorkflowUsage) string { return strconv.FormatFloat(u.Share*100, 'f', 1, 64) }},
+}
+
+// multiHistoryColumns are the columns of the multi-repo history if none are set in the config,
+// the repository comes first to tell the runs of the repositories apart
+var multiHistoryColumns = []string{"repository", "workflow", "title", "status", "started", "duration"}
+
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
 }
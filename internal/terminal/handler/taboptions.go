@@ -2,6 +2,7 @@ package handler
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,6 +26,12 @@ type ModelTabOptions struct {
 
 	optionsWithFunc map[int]func()
 
+	// toggles are the options which switch a setting on and off, they are switched without a confirmation
+	toggles map[int]optionToggle
+
+	// keys are the options which are selected with a letter instead of their numbers
+	keys map[string]int
+
 	timer int
 
 	isTabSelected bool
@@ -32,6 +39,11 @@ type ModelTabOptions struct {
 	cursor int
 }
 
+type optionToggle struct {
+	option string
+	toggle func() bool // switches the setting and returns whether it is on
+}
+
 type OptionStatus string
 
 const (
@@ -65,6 +77,8 @@ func NewOptions(sk *skeleton.Skeleton, modelStatus *ModelStatus) *ModelTabOption
 		options:         initialOptions,
 		optionsAction:   initialOptionsAction,
 		optionsWithFunc: optionsWithFunc,
+		toggles:         make(map[int]optionToggle),
+		keys:            make(map[string]int),
 		optionStatus:    StatusWait,
 		status:          modelStatus,
 	}
@@ -84,16 +98,18 @@ func (o *ModelTabOptions) Update(msg tea.Msg) (*ModelTabOptions, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch keypress := msg.String(); keypress {
-		case "1":
-			o.updateCursor(1)
-		case "2":
-			o.updateCursor(2)
-		case "3":
-			o.updateCursor(3)
-		case "4":
-			o.updateCursor(4)
+		case "1", "2", "3", "4", "5", "6", "7", "8", "9", "0":
+			cursor, _ := strconv.Atoi(keypress)
+			if cursor == 0 {
+				cursor = 10 // 0 is the key of the tenth option, it comes after 9 on the keyboard
+			}
+			o.updateCursor(cursor)
 		case "enter":
 			o.executeOption()
+		default:
+			if cursor, ok := o.keys[keypress]; ok {
+				o.updateCursor(cursor)
+			}
 		}
 	}
 
@@ -101,18 +117,59 @@ func (o *ModelTabOptions) Update(msg tea.Msg) (*ModelTabOptions, tea.Cmd) {
 }
 
 func (o *ModelTabOptions) View() string {
+	maxWidth := o.skeleton.GetTerminalWidth() - 2
+
+	boxes := o.renderBoxes(1)
+	if boxesWidth(boxes) > maxWidth {
+		// Many options don't fit in a narrow terminal with their padding
+		boxes = o.renderBoxes(0)
+	}
+	if boxesWidth(boxes) <= maxWidth {
+		return lipgloss.JoinHorizontal(lipgloss.Top, append([]string{" "}, boxes...)...)
+	}
+
+	// The options still don't fit, the status is shown with the options around the cursor
+	const more = 2 // width of a marker of hidden options
+	first := 1
+	for first < o.cursor && boxesWidth(boxes[:1])+boxesWidth(boxes[first:o.cursor+1])+2*more > maxWidth {
+		first++
+	}
+	last := first
+	for last+1 < len(boxes) && boxesWidth(boxes[:1])+boxesWidth(boxes[first:last+2])+2*more <= maxWidth {
+		last++
+	}
+
+	moreStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).PaddingTop(1)
+	visible := []string{" ", boxes[0]}
+	if first > 1 {
+		visible = append(visible, moreStyle.Render(" ‹"))
+	}
+	visible = append(visible, boxes[first:last+1]...)
+	if last+1 < len(boxes) {
+		visible = append(visible, moreStyle.Render(" ›"))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, visible...)
+}
+
+func boxesWidth(boxes []string) int {
+	width := 1 // the leading space
+	for _, box := range boxes {
+		width += lipgloss.Width(box)
+	}
+	return width
+}
+
+func (o *ModelTabOptions) renderBoxes(padding int) []string {
 	var b = lipgloss.RoundedBorder()
 	b.Right = "├"
 	b.Left = "┤"
 
 	var style = lipgloss.NewStyle().
 		Foreground(lipgloss.Color("15")).
-		Align(lipgloss.Center).Padding(0, 1, 0, 1).
+		Align(lipgloss.Center).Padding(0, padding, 0, padding).
 		Border(b).Foreground(lipgloss.Color("15"))
 
 	var opts []string
-	opts = append(opts, " ")
-
 	for i, option := range o.optionsAction {
 		switch o.optionStatus {
 		case StatusWait:
@@ -131,7 +188,7 @@ func (o *ModelTabOptions) View() string {
 		opts = append(opts, style.Render(option))
 	}
 
-	return lipgloss.JoinHorizontal(lipgloss.Top, opts...)
+	return opts
 }
 
 func (o *ModelTabOptions) resetOptionsWithOriginal() {
@@ -154,6 +211,13 @@ func (o *ModelTabOptions) resetOptionsWithOriginal() {
 }
 
 func (o *ModelTabOptions) updateCursor(cursor int) {
+	if toggle, ok := o.toggles[cursor]; ok {
+		enabled := toggle.toggle()
+		o.options[cursor] = toggleLabel(cursor, toggle.option, enabled)
+		o.optionsAction[cursor] = o.options[cursor]
+		o.status.SetSuccessMessage(fmt.Sprintf("%s is %s", toggle.option, onOff(enabled)))
+		return
+	}
 	if cursor < len(o.options) {
 		o.cursor = cursor
 		o.showAreYouSure()
@@ -161,6 +225,11 @@ func (o *ModelTabOptions) updateCursor(cursor int) {
 	}
 }
 
+// Selected reports whether an option is selected and waits for its confirmation
+func (o *ModelTabOptions) Selected() bool {
+	return o.cursor > 0
+}
+
 func (o *ModelTabOptions) SetStatus(status OptionStatus) {
 	o.optionStatus = status
 	o.options[0] = status.String()
@@ -170,32 +239,80 @@ func (o *ModelTabOptions) SetStatus(status OptionStatus) {
 func (o *ModelTabOptions) AddOption(option string, action func()) {
 	var optionWithNumber string
 	var optionNumber = len(o.options)
-	optionWithNumber = fmt.Sprintf("%d) %s", optionNumber, option)
+	optionWithNumber = fmt.Sprintf("%s) %s", optionKey(optionNumber), option)
 	o.options = append(o.options, optionWithNumber)
 	o.optionsAction = append(o.optionsAction, optionWithNumber)
 	o.optionsWithFunc[optionNumber] = action
 }
 
+// AddKeyOption adds an option which is selected with the key instead of a number, for the options after the tenth
+func (o *ModelTabOptions) AddKeyOption(key string, option string, action func()) {
+	var optionNumber = len(o.options)
+	optionWithKey := fmt.Sprintf("%s) %s", key, option)
+	o.options = append(o.options, optionWithKey)
+	o.optionsAction = append(o.optionsAction, optionWithKey)
+	o.optionsWithFunc[optionNumber] = action
+	o.keys[key] = optionNumber
+}
+
+// AddToggle adds an option which switches a setting on and off, enabled is the state of the setting
+func (o *ModelTabOptions) AddToggle(option string, enabled bool, toggle func() bool) {
+	var optionNumber = len(o.options)
+	o.options = append(o.options, toggleLabel(optionNumber, option, enabled))
+	o.optionsAction = append(o.optionsAction, toggleLabel(optionNumber, option, enabled))
+	o.optionsWithFunc[optionNumber] = func() {} // NO OPERATION, toggles are switched when they are selected
+	o.toggles[optionNumber] = optionToggle{option: option, toggle: toggle}
+}
+
+func toggleLabel(optionNumber int, option string, enabled bool) string {
+	return fmt.Sprintf("%s) %s: %s", optionKey(optionNumber), option, onOff(enabled))
+}
+
+// optionKey is the key which selects the option
+func optionKey(optionNumber int) string {
+	if optionNumber == 10 {
+		return "0"
+	}
+	return strconv.Itoa(optionNumber)
+}
+
+// keyOf returns the key which selects the option, its letter or its number
+func (o *ModelTabOptions) keyOf(optionNumber int) string {
+	for key, number := range o.keys {
+		if number == optionNumber {
+			return key
+		}
+	}
+	return optionKey(optionNumber)
+}
+
+func onOff(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+	return "off"
+}
+
 func (o *ModelTabOptions) getOptionMessage() string {
 	option := o.options[o.cursor]
-	option = strings.TrimPrefix(option, fmt.Sprintf("%d) ", o.cursor))
+	option = strings.TrimPrefix(option, fmt.Sprintf("%s) ", o.keyOf(o.cursor)))
 	return option
 }
 
 func (o *ModelTabOptions) showAreYouSure() {
-	var yellowStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("4")).Blink(true)
-
 	if !o.modelLock {
 		o.previousStatus = *o.status
 		o.modelLock = true
 	}
 	o.status.Reset()
-	o.status.SetProgressMessage(fmt.Sprintf(
-		"Are you sure you want to %s? %s",
-		o.getOptionMessage(),
-		yellowStyle.Render("[ Press Enter ]"),
-	))
+	o.status.SetProgressMessage(areYouSure(o.getOptionMessage()))
+}
+
+// areYouSure asks to confirm the action with enter
+func areYouSure(action string) string {
+	var yellowStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("4")).Blink(true)
 
+	return fmt.Sprintf("Are you sure you want to %s? %s", action, yellowStyle.Render("[ Press Enter ]"))
 }
 
 func (o *ModelTabOptions) switchToPreviousError() {
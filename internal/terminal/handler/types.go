@@ -1,11 +1,13 @@
 package handler
 
 import (
+	"strings"
 	"sync"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/termkit/skeleton"
 )
 
 // SelectedRepository is a struct that holds the selected repository, workflow, and branch
@@ -14,6 +16,21 @@ type SelectedRepository struct {
 	RepositoryName string
 	WorkflowName   string
 	BranchName     string
+
+	// BranchIsTag is set when BranchName is a tag selected in the tag mode of the ref input
+	BranchIsTag bool
+}
+
+// Ref returns the full git ref of the selected branch, tag or ref, other refs are taken as branches
+func (s *SelectedRepository) Ref() string {
+	switch {
+	case strings.HasPrefix(s.BranchName, "refs/"):
+		return s.BranchName
+	case s.BranchIsTag:
+		return "refs/tags/" + s.BranchName
+	default:
+		return "refs/heads/" + s.BranchName
+	}
 }
 
 // Constants
@@ -50,6 +67,49 @@ func NewSelectedRepository() *SelectedRepository {
 	return selectedRepository
 }
 
+// LiveMode is the live mode state shared between the tabs which refresh themselves, it also owns the live widget
+type LiveMode struct {
+	skeleton *skeleton.Skeleton
+
+	mu      sync.RWMutex
+	enabled bool
+}
+
+var (
+	onceLiveMode sync.Once
+	liveMode     *LiveMode
+)
+
+// NewLiveMode returns the shared live mode, enabled is the state of the live mode when it is created first
+func NewLiveMode(sk *skeleton.Skeleton, enabled bool) *LiveMode {
+	onceLiveMode.Do(func() {
+		liveMode = &LiveMode{skeleton: sk, enabled: enabled}
+	})
+	return liveMode
+}
+
+func (l *LiveMode) Enabled() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.enabled
+}
+
+// Toggle switches the live mode on or off for all the tabs, updates the live widget and returns the new state
+func (l *LiveMode) Toggle() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.enabled = !l.enabled
+	l.skeleton.UpdateWidgetValue("live", liveModeWidget(l.enabled))
+	return l.enabled
+}
+
+func liveModeWidget(enabled bool) string {
+	if enabled {
+		return "Live Mode: On"
+	}
+	return "Live Mode: Off"
+}
+
 // Default table styles
 
 func defaultTableStyles() table.Styles {
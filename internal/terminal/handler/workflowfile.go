@@ -0,0 +1,335 @@
+package handler
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gu "github.com/termkit/gama/internal/github/usecase"
+	pw "github.com/termkit/gama/pkg/workflow"
+	py "github.com/termkit/gama/pkg/yaml"
+	"github.com/termkit/skeleton"
+)
+
+// outlineWidth is the width of the jobs outline panel including its borders
+const outlineWidth = 36
+
+// outlineFindings is the number of lint findings listed in the outline, the lines of all findings are marked
+const outlineFindings = 5
+
+// ModelWorkflowFile shows a workflow file with line numbers and YAML highlighting next to the lint findings and the
+// outline of its jobs, the file can be switched to the dependency graph of its jobs
+type ModelWorkflowFile struct {
+	skeleton *skeleton.Skeleton
+	viewport viewport.Model
+	graph    *ModelWorkflowGraph
+
+	visible bool
+
+	path       string
+	ref        string
+	lines      []string // highlighted lines of the file
+	jobs       []py.WorkflowJob
+	jobsGraph  *pw.Graph
+	parseError error
+	findings   []py.Finding
+
+	// The content of the viewport is rebuilt when the width changes, long lines are truncated to the width
+	renderedWidth int
+}
+
+func SetupModelWorkflowFile(sk *skeleton.Skeleton) *ModelWorkflowFile {
+	return &ModelWorkflowFile{
+		skeleton: sk,
+		viewport: viewport.New(0, 0),
+		graph:    SetupModelWorkflowGraph(sk),
+	}
+}
+
+// Open shows the file, the ref is the branch, tag or git ref the file is fetched at
+func (m *ModelWorkflowFile) Open(path string, ref string, file *gu.GetWorkflowFileOutput) {
+	m.path = path
+	m.ref = ref
+	m.jobs = file.Jobs
+	m.jobsGraph = file.Graph
+	m.parseError = file.ParseError
+	m.findings = file.Findings
+
+	m.lines = nil
+	for _, line := range strings.Split(strings.TrimRight(file.Content, "\n"), "\n") {
+		m.lines = append(m.lines, highlightYAML(line))
+	}
+
+	m.renderedWidth = 0
+	m.viewport.GotoTop()
+	m.graph.Close()
+	m.visible = true
+}
+
+func (m *ModelWorkflowFile) Close() {
+	m.graph.Close()
+	m.visible = false
+}
+
+// ToggleGraph switches between the file and the graph of its jobs, it reports false if the jobs have no graph
+func (m *ModelWorkflowFile) ToggleGraph() bool {
+	if m.jobsGraph == nil {
+		return false
+	}
+
+	if m.graph.Visible() {
+		m.graph.Close()
+	} else {
+		m.graph.Open(fmt.Sprintf("%s@%s", m.path, m.ref), m.jobsGraph, nil)
+	}
+	return true
+}
+
+func (m *ModelWorkflowFile) Visible() bool {
+	return m.visible
+}
+
+func (m *ModelWorkflowFile) Update(msg tea.Msg) (*ModelWorkflowFile, tea.Cmd) {
+	var cmd tea.Cmd
+	if m.graph.Visible() {
+		m.graph, cmd = m.graph.Update(msg)
+		return m, cmd
+	}
+
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View renders the file and the outline in the given height
+func (m *ModelWorkflowFile) View(height int) string {
+	if m.graph.Visible() {
+		return m.graph.View(height)
+	}
+
+	fileWidth := m.skeleton.GetTerminalWidth() - outlineWidth - 5
+
+	// Borders and the header line take 3 lines
+	m.viewport.Width = fileWidth - 2
+	m.viewport.Height = max(height-3, 1)
+	if m.renderedWidth != m.viewport.Width {
+		m.renderContent()
+	}
+
+	fileStyle := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("#3b698f")).
+		Width(fileWidth - 2).
+		MarginLeft(1)
+
+	headerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("120"))
+	positionStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+	lastLine := min(m.viewport.YOffset+m.viewport.Height, len(m.lines))
+	header := headerStyle.Render(fmt.Sprintf("%s@%s", m.path, m.ref)) +
+		positionStyle.Render(fmt.Sprintf("  lines %d-%d of %d", m.viewport.YOffset+1, lastLine, len(m.lines)))
+
+	file := fileStyle.Render(lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.NewStyle().MaxWidth(fileWidth-2).Render(header),
+		m.viewport.View()))
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, file, m.renderOutline(height))
+}
+
+func (m *ModelWorkflowFile) renderContent() {
+	m.renderedWidth = m.viewport.Width
+
+	numberWidth := len(fmt.Sprintf("%d", len(m.lines)))
+	numberStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	findingStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("208"))
+	lineStyle := lipgloss.NewStyle().MaxWidth(m.viewport.Width)
+
+	var findingLines = make(map[int]bool)
+	for _, finding := range m.findings {
+		findingLines[finding.Line] = true
+	}
+
+	var content []string
+	for i, line := range m.lines {
+		number := numberStyle.Render(fmt.Sprintf("%*d │ ", numberWidth, i+1))
+		if findingLines[i+1] {
+			number = findingStyle.Render(fmt.Sprintf("%*d ▌ ", numberWidth, i+1))
+		}
+		content = append(content, lineStyle.Render(number+line))
+	}
+
+	m.viewport.SetContent(strings.Join(content, "\n"))
+}
+
+func (m *ModelWorkflowFile) renderOutline(height int) string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		Padding(0, 1).
+		Width(outlineWidth - 2).
+		Height(height - 2).
+		MaxHeight(height)
+
+	titleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("120"))
+	jobStyle := lipgloss.NewStyle().Bold(true)
+	nameStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("250"))
+	detailStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+
+	lint := m.renderFindings()
+	if m.parseError != nil {
+		return style.Render(lipgloss.JoinVertical(lipgloss.Left,
+			lint,
+			titleStyle.Render("Jobs"),
+			errorStyle.Render(fmt.Sprintf("Workflow cannot be parsed: %v", m.parseError))))
+	}
+
+	lines := []string{lint, titleStyle.Render(fmt.Sprintf("Jobs (%d)", len(m.jobs)))}
+	for _, job := range m.jobs {
+		line := jobStyle.Render(job.ID)
+		if job.Name != "" && job.Name != job.ID {
+			line += " " + nameStyle.Render(job.Name)
+		}
+		lines = append(lines, line)
+
+		if len(job.Needs) > 0 {
+			lines = append(lines, detailStyle.Render("  needs: "+strings.Join(job.Needs, ", ")))
+		}
+		if job.Uses != "" {
+			lines = append(lines, detailStyle.Render("  uses: "+job.Uses))
+		} else if len(job.RunsOn) > 0 {
+			lines = append(lines, detailStyle.Render("  runs-on: "+strings.Join(job.RunsOn, ", ")))
+		}
+		if len(job.MatrixAxes) > 0 {
+			lines = append(lines, detailStyle.Render("  matrix: "+strings.Join(job.MatrixAxes, ", ")))
+		}
+	}
+
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+// renderFindings renders the lint findings of the file followed by an empty line
+func (m *ModelWorkflowFile) renderFindings() string {
+	if len(m.findings) == 0 {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Render("Lint: no findings") + "\n"
+	}
+
+	findingStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("208"))
+	messageStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("250"))
+	detailStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+	lines := []string{findingStyle.Render(fmt.Sprintf("Lint (%d)", len(m.findings)))}
+	for _, finding := range m.findings[:min(len(m.findings), outlineFindings)] {
+		lines = append(lines, findingStyle.Render(fmt.Sprintf("L%d ", finding.Line))+messageStyle.Render(finding.Message))
+	}
+	if len(m.findings) > outlineFindings {
+		lines = append(lines, detailStyle.Render(fmt.Sprintf("and %d more, see the marked lines", len(m.findings)-outlineFindings)))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...) + "\n"
+}
+
+// -----------------------------------------------------------------------------
+// YAML Highlighting
+// -----------------------------------------------------------------------------
+
+var (
+	yamlKeyStyle        = lipgloss.NewStyle().Foreground(lipgloss.Color("75"))
+	yamlStringStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("150"))
+	yamlScalarStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("208"))
+	yamlCommentStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	yamlExpressionStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("176"))
+	yamlIndicatorStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("220"))
+
+	// yamlKeyPattern matches the key of a mapping entry, plain or quoted
+	yamlKeyPattern = regexp.MustCompile(`^("[^"]*"|'[^']*'|[^\s#'"\-][^:#]*?|-[^\s:#][^:#]*?)(:)(\s|$)`)
+
+	// yamlScalarPattern matches numbers, booleans and null
+	yamlScalarPattern = regexp.MustCompile(`^(-?\d+(\.\d+)?|true|false|True|False|null|~)$`)
+
+	// yamlExpressionPattern matches ${{ }} expressions of GitHub Actions
+	yamlExpressionPattern = regexp.MustCompile(`\$\{\{.*?\}\}`)
+)
+
+// highlightYAML highlights a single line of YAML, multi-line constructs are highlighted line by line
+func highlightYAML(line string) string {
+	code, comment := splitComment(line)
+
+	var b strings.Builder
+
+	// Indentation and sequence indicators
+	rest := strings.TrimLeft(code, " ")
+	b.WriteString(code[:len(code)-len(rest)])
+	for strings.HasPrefix(rest, "- ") || rest == "-" {
+		b.WriteString(yamlIndicatorStyle.Render("-"))
+		rest = strings.TrimPrefix(rest, "-")
+		trimmed := strings.TrimLeft(rest, " ")
+		b.WriteString(rest[:len(rest)-len(trimmed)])
+		rest = trimmed
+	}
+
+	if match := yamlKeyPattern.FindStringSubmatch(rest); match != nil {
+		b.WriteString(yamlKeyStyle.Render(match[1]))
+		b.WriteString(match[2])
+		rest = rest[len(match[1])+len(match[2]):]
+	}
+
+	b.WriteString(highlightYAMLValue(rest))
+
+	if comment != "" {
+		b.WriteString(yamlCommentStyle.Render(comment))
+	}
+
+	return b.String()
+}
+
+func highlightYAMLValue(value string) string {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return value
+	}
+
+	leading := value[:len(value)-len(strings.TrimLeft(value, " "))]
+	trailing := value[len(strings.TrimRight(value, " ")):]
+
+	switch {
+	case yamlExpressionPattern.MatchString(trimmed):
+		return leading + yamlExpressionPattern.ReplaceAllStringFunc(trimmed, func(expression string) string {
+			return yamlExpressionStyle.Render(expression)
+		}) + trailing
+	case strings.HasPrefix(trimmed, `"`) || strings.HasPrefix(trimmed, `'`):
+		return leading + yamlStringStyle.Render(trimmed) + trailing
+	case yamlScalarPattern.MatchString(trimmed):
+		return leading + yamlScalarStyle.Render(trimmed) + trailing
+	case trimmed == "|" || trimmed == ">" || strings.HasPrefix(trimmed, "|-") || strings.HasPrefix(trimmed, ">-") ||
+		strings.HasPrefix(trimmed, "&") || strings.HasPrefix(trimmed, "*"):
+		return leading + yamlIndicatorStyle.Render(trimmed) + trailing
+	}
+
+	return value
+}
+
+// splitComment splits the line at the first # which starts a comment, a # inside quotes or a word is not a comment
+func splitComment(line string) (string, string) {
+	var quote rune
+	for i, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			// Quotes start a string only at the beginning of a value
+			if i == 0 || strings.ContainsRune(" :-[{,", rune(line[i-1])) {
+				quote = r
+			}
+		case r == '#':
+			if i == 0 || line[i-1] == ' ' {
+				return line[:i], line[i:]
+			}
+		}
+	}
+	return line, ""
+}
@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	gu "github.com/termkit/gama/internal/github/usecase"
+	pw "github.com/termkit/gama/pkg/workflow"
+	"github.com/termkit/skeleton"
+)
+
+// graphScrollStep is the number of columns the graph scrolls horizontally per key press
+const graphScrollStep = 8
+
+// ModelWorkflowGraph shows the dependency graph of the jobs of a workflow, the jobs are colored by their states
+// when the graph belongs to a run
+type ModelWorkflowGraph struct {
+	skeleton *skeleton.Skeleton
+	viewport viewport.Model
+
+	visible bool
+
+	title   string
+	lines   []string // rendered lines of the graph
+	width   int      // width of the widest line
+	xOffset int
+
+	// states is a map of job id and its state in the run, it is nil if the graph does not belong to a run
+	states map[string]string
+
+	// jobs are the jobs of the run which can be selected, they are nil if the graph does not belong to a run
+	jobs      []gu.RunJob
+	jobCursor int
+}
+
+// jobStateColors are the colors of the states of the jobs, they follow the colors of GitHub
+var jobStateColors = map[string]lipgloss.Color{
+	"success":         "42",
+	"failure":         "9",
+	"timed_out":       "9",
+	"startup_failure": "9",
+	"cancelled":       "245",
+	"action_required": "208",
+	"in_progress":     "220",
+	"queued":          "75",
+	"waiting":         "75",
+	"requested":       "75",
+	"pending":         "75",
+	"neutral":         "240",
+	"skipped":         "240",
+}
+
+// jobStateLegend is the order of the states in the legend
+var jobStateLegend = []string{"success", "failure", "in_progress", "queued", "cancelled", "skipped"}
+
+func SetupModelWorkflowGraph(sk *skeleton.Skeleton) *ModelWorkflowGraph {
+	return &ModelWorkflowGraph{
+		skeleton: sk,
+		viewport: viewport.New(0, 0),
+	}
+}
+
+// Open shows the graph, states are the states of the jobs in a run or nil
+func (m *ModelWorkflowGraph) Open(title string, graph *pw.Graph, states map[string]string) {
+	m.Refresh(title, graph, states)
+	m.jobs = nil
+	m.jobCursor = 0
+	m.xOffset = 0
+	m.viewport.GotoTop()
+	m.visible = true
+}
+
+// Refresh replaces the graph and keeps the scroll position
+func (m *ModelWorkflowGraph) Refresh(title string, graph *pw.Graph, states map[string]string) {
+	m.title = title
+	m.states = states
+
+	m.lines = strings.Split(graph.Render(m.decorateJob), "\n")
+	m.width = 0
+	for _, line := range m.lines {
+		m.width = max(m.width, lipgloss.Width(line))
+	}
+	m.xOffset = min(m.xOffset, max(m.width-m.viewport.Width, 0))
+}
+
+// SetJobs sets the jobs of the run which can be selected, the selected job is kept if it is still listed
+func (m *ModelWorkflowGraph) SetJobs(jobs []gu.RunJob) {
+	selected, ok := m.SelectedJob()
+	m.jobs = jobs
+	m.jobCursor = 0
+	for i, job := range jobs {
+		if ok && job.ID == selected.ID {
+			m.jobCursor = i
+		}
+	}
+}
+
+// SelectedJob returns the selected job of the run
+func (m *ModelWorkflowGraph) SelectedJob() (gu.RunJob, bool) {
+	if m.jobCursor < 0 || m.jobCursor >= len(m.jobs) {
+		return gu.RunJob{}, false
+	}
+	return m.jobs[m.jobCursor], true
+}
+
+func (m *ModelWorkflowGraph) NextJob() {
+	if len(m.jobs) > 0 {
+		m.jobCursor = (m.jobCursor + 1) % len(m.jobs)
+	}
+}
+
+func (m *ModelWorkflowGraph) PrevJob() {
+	if len(m.jobs) > 0 {
+		m.jobCursor = (m.jobCursor - 1 + len(m.jobs)) % len(m.jobs)
+	}
+}
+
+func (m *ModelWorkflowGraph) Close() {
+	m.visible = false
+}
+
+func (m *ModelWorkflowGraph) Visible() bool {
+	return m.visible
+}
+
+func (m *ModelWorkflowGraph) Update(msg tea.Msg) (*ModelWorkflowGraph, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch keyMsg.String() {
+		case "left":
+			m.xOffset = max(m.xOffset-graphScrollStep, 0)
+			return m, nil
+		case "right":
+			m.xOffset = max(min(m.xOffset+graphScrollStep, m.width-m.viewport.Width), 0)
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View renders the graph in the given height
+func (m *ModelWorkflowGraph) View(height int) string {
+	width := m.skeleton.GetTerminalWidth() - 4
+
+	// Borders, the header and the legend take 4 lines, the selected job of a run takes another one
+	headerLines := 4
+	if m.jobs != nil {
+		headerLines++
+	}
+	m.viewport.Width = width - 2
+	m.viewport.Height = max(height-headerLines, 1)
+
+	var visible = make([]string, 0, len(m.lines))
+	for _, line := range m.lines {
+		visible = append(visible, ansi.Cut(line, m.xOffset, m.xOffset+m.viewport.Width))
+	}
+	m.viewport.SetContent(strings.Join(visible, "\n"))
+
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("#3b698f")).
+		Width(width - 2).
+		MarginLeft(1)
+
+	headerStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("120"))
+	positionStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+	header := headerStyle.Render(m.title)
+	if m.width > m.viewport.Width {
+		header += positionStyle.Render(fmt.Sprintf("  columns %d-%d of %d, ←/→ to scroll",
+			m.xOffset+1, min(m.xOffset+m.viewport.Width, m.width), m.width))
+	}
+
+	lines := []string{
+		lipgloss.NewStyle().MaxWidth(width - 2).Render(header),
+		lipgloss.NewStyle().MaxWidth(width - 2).Render(m.renderLegend()),
+	}
+	if m.jobs != nil {
+		lines = append(lines, lipgloss.NewStyle().MaxWidth(width-2).Render(m.renderSelectedJob()))
+	}
+	lines = append(lines, m.viewport.View())
+
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+func (m *ModelWorkflowGraph) renderSelectedJob() string {
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+	job, ok := m.SelectedJob()
+	if !ok {
+		return mutedStyle.Render("The run has no jobs yet")
+	}
+
+	color, ok := jobStateColors[job.State]
+	if !ok {
+		color = "252"
+	}
+	return mutedStyle.Render(fmt.Sprintf("Job %d of %d  ", m.jobCursor+1, len(m.jobs))) +
+		lipgloss.NewStyle().Bold(true).Render(job.Name) + "  " +
+		lipgloss.NewStyle().Foreground(color).Render("■ "+strings.ReplaceAll(job.State, "_", " ")) +
+		mutedStyle.Render("  tab/shift+tab to select a job")
+}
+
+func (m *ModelWorkflowGraph) decorateJob(job string, text string) string {
+	if m.states == nil {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("252")).Render(text)
+	}
+
+	state, ok := m.states[job]
+	if !ok {
+		// The job did not run, like a job of a run which is cancelled before it
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("238")).Render(text)
+	}
+
+	color, ok := jobStateColors[state]
+	if !ok {
+		color = "252"
+	}
+	return lipgloss.NewStyle().Foreground(color).Render(text)
+}
+
+func (m *ModelWorkflowGraph) renderLegend() string {
+	if m.states == nil {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render("Jobs flow from left to right")
+	}
+
+	var items []string
+	for _, state := range jobStateLegend {
+		items = append(items, lipgloss.NewStyle().Foreground(jobStateColors[state]).Render("■ "+strings.ReplaceAll(state, "_", " ")))
+	}
+	items = append(items, lipgloss.NewStyle().Foreground(lipgloss.Color("238")).Render("■ not run"))
+
+	return strings.Join(items, "  ")
+}
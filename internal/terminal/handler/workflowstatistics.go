@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	gu "github.com/termkit/gama/internal/github/usecase"
+	"github.com/termkit/skeleton"
+)
+
+// sparkLevels are the bars of a sparkline from the lowest value to the highest one
+var sparkLevels = []rune("▁▂▃▄▅▆▇█")
+
+// ModelWorkflowStatistics shows the success rate, the durations, the queue times and the failure streaks of
+// a workflow in a window of days
+type ModelWorkflowStatistics struct {
+	skeleton *skeleton.Skeleton
+
+	visible      bool
+	workflowName string
+	statistics   []gu.WorkflowStatistics // statistics of the windows of gu.StatisticsWindows
+	window       int                     // index of the shown window
+}
+
+func SetupModelWorkflowStatistics(sk *skeleton.Skeleton) *ModelWorkflowStatistics {
+	return &ModelWorkflowStatistics{
+		skeleton: sk,
+	}
+}
+
+// Open shows the statistics of the workflow, the shown window is kept if the statistics are replaced
+func (m *ModelWorkflowStatistics) Open(workflowName string, statistics []gu.WorkflowStatistics) {
+	m.workflowName = workflowName
+	m.statistics = statistics
+	m.window = min(m.window, max(len(statistics)-1, 0))
+	m.visible = true
+}
+
+func (m *ModelWorkflowStatistics) Close() {
+	m.visible = false
+}
+
+func (m *ModelWorkflowStatistics) Visible() bool {
+	return m.visible
+}
+
+// PrevWindow shows the statistics of the shorter window
+func (m *ModelWorkflowStatistics) PrevWindow() {
+	m.window = max(m.window-1, 0)
+}
+
+// NextWindow shows the statistics of the longer window
+func (m *ModelWorkflowStatistics) NextWindow() {
+	m.window = min(m.window+1, max(len(m.statistics)-1, 0))
+}
+
+// View renders the statistics in the given height
+func (m *ModelWorkflowStatistics) View(height int) string {
+	width := m.skeleton.GetTerminalWidth() - 4
+
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Width(16)
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	activeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("229")).Background(lipgloss.Color("57"))
+	successStyle := lipgloss.NewStyle().Foreground(jobStateColors["success"])
+	failureStyle := lipgloss.NewStyle().Foreground(jobStateColors["failure"])
+
+	var windows []string
+	for i, statistics := range m.statistics {
+		label := fmt.Sprintf(" %dd ", statistics.Days)
+		if i == m.window {
+			label = activeStyle.Render(label)
+		}
+		windows = append(windows, label)
+	}
+	title := lipgloss.NewStyle().Foreground(lipgloss.Color("120")).Render(m.workflowName) + "  " + strings.Join(windows, " ")
+
+	var lines []string
+	field := func(label, value string) {
+		lines = append(lines, labelStyle.Render(label)+value)
+	}
+
+	if len(m.statistics) == 0 {
+		lines = append(lines, mutedStyle.Render("No statistics"))
+	} else {
+		statistics := m.statistics[m.window]
+		// The label and the values before the sparklines take about 40 columns
+		sparkWidth := max(width-44, 10)
+
+		field("Runs", fmt.Sprintf("%d  %s  %s  %s",
+			statistics.Runs,
+			successStyle.Render(fmt.Sprintf("%d succeeded", statistics.Succeeded)),
+			failureStyle.Render(fmt.Sprintf("%d failed", statistics.Failed)),
+			mutedStyle.Render(fmt.Sprintf("%d cancelled or skipped", statistics.Cancelled))))
+		if statistics.Truncated {
+			field("", failureStyle.Render("The oldest runs are left out, GitHub lists up to 1000 runs"))
+		}
+
+		rate := "-"
+		if statistics.Succeeded+statistics.Failed > 0 {
+			rate = fmt.Sprintf("%.1f%%", statistics.SuccessRate*100)
+		}
+		field("Success rate", fmt.Sprintf("%-24s%s", rate, sparkline(statistics.DailySuccessRates, sparkWidth, 0, 1)))
+
+		durations := "-"
+		if len(statistics.Durations) > 0 {
+			durations = fmt.Sprintf("p50 %s  p90 %s", formatStatisticsDuration(statistics.DurationP50),
+				formatStatisticsDuration(statistics.DurationP90))
+		}
+		field("Duration", fmt.Sprintf("%-24s%s", durations, durationSparkline(statistics.Durations, sparkWidth)))
+
+		queueTimes := "-"
+		if len(statistics.QueueTimes) > 0 {
+			queueTimes = fmt.Sprintf("p50 %s  p90 %s", formatStatisticsDuration(statistics.QueueP50),
+				formatStatisticsDuration(statistics.QueueP90))
+		}
+		field("Queue time", fmt.Sprintf("%-24s%s", queueTimes, durationSparkline(statistics.QueueTimes, sparkWidth)))
+
+		streak := fmt.Sprintf("longest %d, current %d", statistics.LongestFailureStreak, statistics.CurrentFailureStreak)
+		if statistics.CurrentFailureStreak > 0 {
+			streak = failureStyle.Render(streak)
+		}
+		field("Failure streak", streak)
+		field("Flaky reruns", fmt.Sprintf("%d %s", statistics.Flaky, mutedStyle.Render("runs succeeded after a re-run")))
+	}
+
+	footer := mutedStyle.Render("Success rates are per day, durations of succeeded and failed runs and queue times per run, the oldest first")
+
+	// Borders, the title, the footer and the blank lines take 6 lines
+	if maxLines := max(height-6, 1); len(lines) > maxLines {
+		lines = lines[:maxLines]
+	}
+
+	style := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("#3b698f")).
+		Width(width - 2).
+		MarginLeft(1)
+
+	lineStyle := lipgloss.NewStyle().MaxWidth(width - 2)
+	content := []string{lineStyle.Render(title), ""}
+	for _, line := range lines {
+		content = append(content, lineStyle.Render(line))
+	}
+	content = append(content, "", lineStyle.Render(footer))
+
+	return style.Render(lipgloss.JoinVertical(lipgloss.Left, content...))
+}
+
+func durationSparkline(durations []time.Duration, width int) string {
+	values := make([]float64, len(durations))
+	var highest float64
+	for i, duration := range durations {
+		values[i] = duration.Seconds()
+		highest = max(highest, values[i])
+	}
+	return sparkline(values, width, 0, highest)
+}
+
+// sparkline draws the values between low and high in at most width bars, neighbouring values are averaged if
+// there are more values than bars. Negative values are missing ones, they are drawn as dots.
+func sparkline(values []float64, width int, low, high float64) string {
+	if len(values) == 0 || width <= 0 {
+		return ""
+	}
+
+	bars := min(len(values), width)
+	var b strings.Builder
+	for bar := range bars {
+		// Average the values of the bar, the missing ones are skipped
+		var sum float64
+		var count int
+		for _, value := range values[bar*len(values)/bars : (bar+1)*len(values)/bars] {
+			if value >= 0 {
+				sum += value
+				count++
+			}
+		}
+		if count == 0 {
+			b.WriteRune('·')
+			continue
+		}
+
+		level := 0
+		if high > low {
+			level = int((sum/float64(count) - low) / (high - low) * float64(len(sparkLevels)-1))
+		}
+		b.WriteRune(sparkLevels[min(max(level, 0), len(sparkLevels)-1)])
+	}
+	return b.String()
+}
+
+func formatStatisticsDuration(duration time.Duration) string {
+	return duration.Round(time.Second).String()
+}
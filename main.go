@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/termkit/gama/internal/cli"
 	"github.com/termkit/gama/internal/config"
 	gr "github.com/termkit/gama/internal/github/repository"
 	gu "github.com/termkit/gama/internal/github/usecase"
@@ -21,6 +22,11 @@ const (
 var Version = "under development" // will be set by build flag
 
 func main() {
+	// Linting works on local files, it doesn't need a config or a token
+	if len(os.Args) > 1 && os.Args[1] == "lint" {
+		os.Exit(cli.Lint(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		panic(fmt.Sprintf("failed to load config: %v", err))
@@ -31,6 +37,11 @@ func main() {
 	githubRepository := gr.New(cfg)
 	githubUseCase := gu.New(githubRepository)
 
+	// Deleting old runs is a command, it doesn't start the terminal
+	if len(os.Args) > 1 && os.Args[1] == "delete-runs" {
+		os.Exit(cli.DeleteRuns(os.Args[2:], githubUseCase, os.Stdout, os.Stderr))
+	}
+
 	terminal := th.SetupTerminal(githubUseCase, version)
 	if _, err := tea.NewProgram(terminal).Run(); err != nil {
 		fmt.Println("Error running program:", err)
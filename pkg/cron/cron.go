@@ -0,0 +1,219 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var monthNames = []string{"", "January", "February", "March", "April", "May", "June",
+	"July", "August", "September", "October", "November", "December"}
+
+var weekdayNames = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+// field is a cron field with its allowed range and the names that can be used instead of numbers
+type field struct {
+	name  string
+	min   int
+	max   int
+	alias map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	dayField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, alias: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	weekdayField = field{name: "day of week", min: 0, max: 7, alias: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// Describe returns a human-readable description of a five-field cron expression, like the ones of the schedule event.
+// GitHub runs schedules in UTC, so the times are described in UTC.
+func Describe(expr string) (string, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return "", fmt.Errorf("cron expression must have 5 fields, got %d", len(fields))
+	}
+
+	minute, hour, day, month, weekday := fields[0], fields[1], fields[2], fields[3], fields[4]
+	for i, f := range []field{minuteField, hourField, dayField, monthField, weekdayField} {
+		if err := f.validate(fields[i]); err != nil {
+			return "", err
+		}
+	}
+
+	parts := []string{describeTime(minute, hour)}
+
+	switch {
+	case day == "*" && weekday == "*":
+		if isNumber(minute) && isNumber(hour) {
+			parts = append(parts, "every day")
+		}
+	case day == "*":
+		parts = append(parts, "on "+describeList(weekday, weekdayField, weekdayNames))
+	case weekday == "*":
+		if step, ok := everyStep(day); ok {
+			parts = append(parts, fmt.Sprintf("every %d days", step))
+		} else {
+			parts = append(parts, "on day "+describeList(day, dayField, nil)+" of the month")
+		}
+	default:
+		// Cron runs when either of the day fields matches
+		parts = append(parts, fmt.Sprintf("on day %s of the month or on %s",
+			describeList(day, dayField, nil), describeList(weekday, weekdayField, weekdayNames)))
+	}
+
+	if month != "*" {
+		parts = append(parts, "in "+describeList(month, monthField, monthNames))
+	}
+
+	return strings.Join(parts, " "), nil
+}
+
+func describeTime(minute, hour string) string {
+	switch {
+	case isNumber(minute) && isNumber(hour):
+		m, _ := strconv.Atoi(minute)
+		h, _ := strconv.Atoi(hour)
+		return fmt.Sprintf("at %02d:%02d UTC", h, m)
+	case minute == "*" && hour == "*":
+		return "every minute"
+	case hour == "*":
+		if step, ok := everyStep(minute); ok {
+			return fmt.Sprintf("every %d minutes", step)
+		}
+		if minute == "0" {
+			return "every hour"
+		}
+		return fmt.Sprintf("at minute %s past every hour", describeList(minute, minuteField, nil))
+	}
+
+	if step, ok := everyStep(hour); ok {
+		if minute == "0" {
+			return fmt.Sprintf("every %d hours", step)
+		}
+		return fmt.Sprintf("at minute %s every %d hours", describeList(minute, minuteField, nil), step)
+	}
+
+	return fmt.Sprintf("at minute %s past hour %s UTC",
+		describeList(minute, minuteField, nil), describeList(hour, hourField, nil))
+}
+
+// describeList describes a field like "1,3-5" as "1 and 3 through 5", names replace the numbers when they are given
+func describeList(value string, f field, names []string) string {
+	if value == "*" {
+		return "every " + f.name
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		rangePart, step, hasStep := strings.Cut(item, "/")
+
+		var desc string
+		if rangePart == "*" {
+			desc = fmt.Sprintf("every %s %s", ordinal(step), f.name)
+		} else {
+			start, end, isRange := strings.Cut(rangePart, "-")
+			desc = f.display(start, names)
+			if isRange {
+				desc += " through " + f.display(end, names)
+			}
+			if hasStep {
+				desc = fmt.Sprintf("every %s %s from %s", ordinal(step), f.name, desc)
+			}
+		}
+		items = append(items, desc)
+	}
+
+	if len(items) == 1 {
+		return items[0]
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
+
+// everyStep reports the step of a "*/n" field
+func everyStep(value string) (int, bool) {
+	step, ok := strings.CutPrefix(value, "*/")
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(step)
+	return n, err == nil
+}
+
+func (f field) validate(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		rangePart, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			if n, err := strconv.Atoi(step); err != nil || n <= 0 {
+				return fmt.Errorf("invalid step %q in %s field", step, f.name)
+			}
+		}
+		if rangePart == "*" {
+			continue
+		}
+
+		start, end, isRange := strings.Cut(rangePart, "-")
+		if _, err := f.value(start); err != nil {
+			return err
+		}
+		if isRange {
+			if _, err := f.value(end); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (f field) value(value string) (int, error) {
+	if n, ok := f.alias[strings.ToLower(value)]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", value, f.name)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d in %s field", n, f.min, f.max, f.name)
+	}
+	return n, nil
+}
+
+func (f field) display(value string, names []string) string {
+	n, err := f.value(value)
+	if err != nil || names == nil {
+		return value
+	}
+	return names[n]
+}
+
+func isNumber(value string) bool {
+	_, err := strconv.Atoi(value)
+	return err == nil
+}
+
+func ordinal(value string) string {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return value
+	}
+
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
@@ -0,0 +1,50 @@
+package cron
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "* * * * *", want: "every minute"},
+		{expr: "*/15 * * * *", want: "every 15 minutes"},
+		{expr: "0 * * * *", want: "every hour"},
+		{expr: "30 * * * *", want: "at minute 30 past every hour"},
+		{expr: "0 */6 * * *", want: "every 6 hours"},
+		{expr: "30 5 * * *", want: "at 05:30 UTC every day"},
+		{expr: "30 5 * * 1,3", want: "at 05:30 UTC on Monday and Wednesday"},
+		{expr: "0 0 * * MON-FRI", want: "at 00:00 UTC on Monday through Friday"},
+		{expr: "0 0 1 * *", want: "at 00:00 UTC on day 1 of the month"},
+		{expr: "0 12 1 jan,jul *", want: "at 12:00 UTC on day 1 of the month in January and July"},
+		{expr: "0 9-17 * * 1-5", want: "at minute 0 past hour 9 through 17 UTC on Monday through Friday"},
+		{expr: "0 0 */2 * *", want: "at 00:00 UTC every 2 days"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := Describe(tt.expr)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDescribe_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * funday",
+		"*/0 * * * *",
+	} {
+		_, err := Describe(expr)
+		assert.Error(t, err, expr)
+	}
+}
@@ -0,0 +1,105 @@
+package fuzzy
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Match is a candidate which contains all characters of the pattern in order
+type Match struct {
+	Value string
+	Index int // index of the candidate in the given list
+	Score int
+}
+
+const (
+	scoreMatch       = 1
+	scoreConsecutive = 4
+	scoreBoundary    = 6
+	scorePrefix      = 8
+	scoreExact       = 100
+	penaltyGap       = 1
+)
+
+// Find returns the candidates matching the pattern, best matches first.
+// Matching is case-insensitive, characters of the pattern must appear in the candidate in the same order.
+// An empty pattern matches every candidate.
+func Find(pattern string, candidates []string) []Match {
+	var matches []Match
+	for i, candidate := range candidates {
+		score, ok := Score(pattern, candidate)
+		if !ok {
+			continue
+		}
+		matches = append(matches, Match{
+			Value: candidate,
+			Index: i,
+			Score: score,
+		})
+	}
+
+	// Keep the given order if there is nothing to rank by
+	if pattern == "" {
+		return matches
+	}
+
+	slices.SortStableFunc(matches, func(a, b Match) int {
+		if a.Score != b.Score {
+			return b.Score - a.Score
+		}
+		return utf8.RuneCountInString(a.Value) - utf8.RuneCountInString(b.Value)
+	})
+
+	return matches
+}
+
+// Score returns the score of the candidate for the pattern and whether it matches at all
+func Score(pattern string, candidate string) (int, bool) {
+	if pattern == "" {
+		return 0, true
+	}
+
+	p := []rune(strings.ToLower(pattern))
+	c := []rune(strings.ToLower(candidate))
+
+	var score int
+	var pi int
+	var lastMatch = -1
+	for ci := 0; ci < len(c) && pi < len(p); ci++ {
+		if c[ci] != p[pi] {
+			continue
+		}
+
+		score += scoreMatch
+		switch {
+		case lastMatch >= 0 && ci == lastMatch+1:
+			score += scoreConsecutive
+		case lastMatch >= 0:
+			score -= penaltyGap * (ci - lastMatch - 1)
+		}
+		if ci == 0 || isBoundary(c[ci-1]) {
+			score += scoreBoundary
+		}
+
+		lastMatch = ci
+		pi++
+	}
+
+	if pi < len(p) {
+		return 0, false
+	}
+
+	if strings.HasPrefix(string(c), string(p)) {
+		score += scorePrefix
+	}
+	if string(c) == string(p) {
+		score += scoreExact
+	}
+
+	return score, true
+}
+
+func isBoundary(r rune) bool {
+	return r == '/' || r == '-' || r == '_' || r == '.' || r == ' '
+}
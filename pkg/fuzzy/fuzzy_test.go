@@ -0,0 +1,59 @@
+package fuzzy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFind(t *testing.T) {
+	var candidates = []string{
+		"main",
+		"feature/login-page",
+		"feature/logout",
+		"release/1.0",
+		"fix/main-menu",
+		"maintenance",
+	}
+
+	tests := []struct {
+		name    string
+		pattern string
+		want    []string
+	}{
+		{
+			name:    "empty pattern keeps everything",
+			pattern: "",
+			want:    []string{"main", "feature/login-page", "feature/logout", "release/1.0", "fix/main-menu", "maintenance"},
+		},
+		{
+			name:    "exact match first",
+			pattern: "main",
+			want:    []string{"main", "maintenance", "fix/main-menu"},
+		},
+		{
+			name:    "subsequence",
+			pattern: "flp",
+			want:    []string{"feature/login-page"},
+		},
+		{
+			name:    "case insensitive",
+			pattern: "REL",
+			want:    []string{"release/1.0", "feature/logout", "feature/login-page"},
+		},
+		{
+			name:    "no match",
+			pattern: "xyz",
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, match := range Find(tt.pattern, candidates) {
+				got = append(got, match.Value)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
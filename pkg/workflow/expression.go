@@ -0,0 +1,906 @@
+package workflow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Context holds the contexts an expression can read like inputs and github, the values are the ones decoded from
+// JSON: nil, bool, float64, string, []any and map[string]any
+type Context map[string]any
+
+// ErrUnavailable is returned when an expression reads something which is only known while the workflow runs,
+// like the outputs of another job
+var ErrUnavailable = errors.New("not available before the run")
+
+// NewDispatchContext returns the contexts of a workflow_dispatch run with the given inputs at the given ref.
+// A ref which is not a full git ref is taken as a branch. Like in a run, github.event.inputs holds the inputs as
+// strings while inputs keeps their types.
+func NewDispatchContext(repository string, ref string, inputs map[string]any) Context {
+	if inputs == nil {
+		inputs = make(map[string]any)
+	}
+
+	eventInputs := make(map[string]any, len(inputs))
+	for name, value := range inputs {
+		eventInputs[name] = toString(value)
+	}
+
+	fullRef, refType := ref, "branch"
+	switch {
+	case strings.HasPrefix(ref, "refs/tags/"):
+		refType = "tag"
+	case !strings.HasPrefix(ref, "refs/"):
+		fullRef = "refs/heads/" + ref
+	}
+	refName := strings.TrimPrefix(strings.TrimPrefix(fullRef, "refs/heads/"), "refs/tags/")
+
+	return Context{
+		"inputs": inputs,
+		"github": map[string]any{
+			"event_name": "workflow_dispatch",
+			"repository": repository,
+			"ref":        fullRef,
+			"ref_name":   refName,
+			"ref_type":   refType,
+			"event": map[string]any{
+				"inputs": eventInputs,
+			},
+		},
+	}
+}
+
+var templatePattern = regexp.MustCompile(`\$\{\{(.*?)\}\}`)
+
+// EvaluateTemplate evaluates the ${{ }} expressions in the value. A value which is a single expression evaluates to
+// the result of the expression, otherwise the results are written into the value as strings.
+func EvaluateTemplate(value string, ctx Context) (any, error) {
+	matches := templatePattern.FindAllStringSubmatchIndex(value, -1)
+	if len(matches) == 1 && matches[0][0] == 0 && matches[0][1] == len(value) {
+		return Evaluate(value[matches[0][2]:matches[0][3]], ctx)
+	}
+
+	var result strings.Builder
+	var last int
+	for _, match := range matches {
+		evaluated, err := Evaluate(value[match[2]:match[3]], ctx)
+		if err != nil {
+			return nil, err
+		}
+		result.WriteString(value[last:match[0]])
+		result.WriteString(toString(evaluated))
+		last = match[1]
+	}
+	result.WriteString(value[last:])
+	return result.String(), nil
+}
+
+// EvaluateCondition evaluates an if condition, the ${{ }} around the condition is optional
+func EvaluateCondition(condition string, ctx Context) (bool, error) {
+	condition = strings.TrimSpace(condition)
+	if condition == "" {
+		return true, nil
+	}
+
+	if !strings.Contains(condition, "${{") {
+		condition = "${{ " + condition + " }}"
+	}
+	value, err := EvaluateTemplate(condition, ctx)
+	if err != nil {
+		return false, err
+	}
+	return truthy(value), nil
+}
+
+// Evaluate evaluates an expression without the ${{ }} around it
+func Evaluate(expression string, ctx Context) (any, error) {
+	node, err := parseExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	return node.eval(ctx)
+}
+
+func parseExpression(expression string) (exprNode, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q in expression", p.peek().value)
+	}
+	return node, nil
+}
+
+var statusFunctionPattern = regexp.MustCompile(`(?i)\b(success|failure|always|cancelled)\s*\(`)
+
+// hasStatusFunction reports whether the condition calls a status function, a condition without one implies success()
+func hasStatusFunction(condition string) bool {
+	return statusFunctionPattern.MatchString(condition)
+}
+
+// -----------------------------------------------------------------------------
+// Lexer
+// -----------------------------------------------------------------------------
+
+type tokenKind int
+
+const (
+	tokenNumber tokenKind = iota
+	tokenString
+	tokenIdentifier
+	tokenPunctuation
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+func tokenize(expression string) ([]token, error) {
+	var tokens []token
+	runes := []rune(expression)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'':
+			// Strings are single quoted, a quote is escaped by doubling it
+			var value strings.Builder
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, errors.New("unterminated string in expression")
+				}
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						value.WriteRune('\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenString, value: value.String()})
+		case unicode.IsDigit(r) || (r == '-' || r == '+' || r == '.') && i+1 < len(runes) && unicode.IsDigit(runes[i+1]) &&
+			!precedesOperand(tokens):
+			start := i
+			i++
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '.' ||
+				(runes[i] == '-' || runes[i] == '+') && (runes[i-1] == 'e' || runes[i-1] == 'E')) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: string(runes[start:i])})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '-') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdentifier, value: string(runes[start:i])})
+		default:
+			two := ""
+			if i+1 < len(runes) {
+				two = string(runes[i : i+2])
+			}
+			switch {
+			case two == "==" || two == "!=" || two == "<=" || two == ">=" || two == "&&" || two == "||":
+				tokens = append(tokens, token{kind: tokenPunctuation, value: two})
+				i += 2
+			case strings.ContainsRune("()[].,!<>*", r):
+				tokens = append(tokens, token{kind: tokenPunctuation, value: string(r)})
+				i++
+			default:
+				return nil, fmt.Errorf("unexpected character %q in expression", r)
+			}
+		}
+	}
+
+	return tokens, nil
+}
+
+// precedesOperand reports whether the last token ends an operand, then a sign or a dot is not part of a number
+func precedesOperand(tokens []token) bool {
+	if len(tokens) == 0 {
+		return false
+	}
+	last := tokens[len(tokens)-1]
+	return last.kind != tokenPunctuation || last.value == ")" || last.value == "]" || last.value == "*"
+}
+
+// -----------------------------------------------------------------------------
+// Parser
+// -----------------------------------------------------------------------------
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) accept(values ...string) (string, bool) {
+	if t := p.peek(); t.kind == tokenPunctuation && !p.done() {
+		for _, value := range values {
+			if t.value == value {
+				p.pos++
+				return value, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (p *parser) expect(value string) error {
+	if _, ok := p.accept(value); !ok {
+		if p.done() {
+			return fmt.Errorf("expected %q at the end of expression", value)
+		}
+		return fmt.Errorf("expected %q in expression, got %q", value, p.peek().value)
+	}
+	return nil
+}
+
+func (p *parser) parseOr() (exprNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.accept("||"); !ok {
+			return left, nil
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalNode{operator: "||", left: left, right: right}
+	}
+}
+
+func (p *parser) parseAnd() (exprNode, error) {
+	left, err := p.parseEquality()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.accept("&&"); !ok {
+			return left, nil
+		}
+		right, err := p.parseEquality()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalNode{operator: "&&", left: left, right: right}
+	}
+}
+
+func (p *parser) parseEquality() (exprNode, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		operator, ok := p.accept("==", "!=")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		left = compareNode{operator: operator, left: left, right: right}
+	}
+}
+
+func (p *parser) parseComparison() (exprNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		operator, ok := p.accept("<", "<=", ">", ">=")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = compareNode{operator: operator, left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary() (exprNode, error) {
+	if _, ok := p.accept("!"); ok {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	}
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (exprNode, error) {
+	node, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		if _, ok := p.accept("."); ok {
+			if _, ok := p.accept("*"); ok {
+				node = filterNode{target: node}
+				continue
+			}
+			t := p.peek()
+			if t.kind != tokenIdentifier || p.done() {
+				return nil, errors.New("expected a property name after '.' in expression")
+			}
+			p.pos++
+			node = indexNode{target: node, index: literalNode{value: t.value}}
+			continue
+		}
+
+		if _, ok := p.accept("["); ok {
+			if _, ok := p.accept("*"); ok {
+				if err := p.expect("]"); err != nil {
+					return nil, err
+				}
+				node = filterNode{target: node}
+				continue
+			}
+			index, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			node = indexNode{target: node, index: index}
+			continue
+		}
+
+		return node, nil
+	}
+}
+
+func (p *parser) parsePrimary() (exprNode, error) {
+	if p.done() {
+		return nil, errors.New("unexpected end of expression")
+	}
+
+	t := p.peek()
+	p.pos++
+
+	switch t.kind {
+	case tokenNumber:
+		number, err := parseNumber(t.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q in expression", t.value)
+		}
+		return literalNode{value: number}, nil
+	case tokenString:
+		return literalNode{value: t.value}, nil
+	case tokenIdentifier:
+		switch t.value {
+		case "null":
+			return literalNode{value: nil}, nil
+		case "true":
+			return literalNode{value: true}, nil
+		case "false":
+			return literalNode{value: false}, nil
+		case "NaN":
+			return literalNode{value: math.NaN()}, nil
+		case "Infinity":
+			return literalNode{value: math.Inf(1)}, nil
+		}
+
+		if _, ok := p.accept("("); ok {
+			var args []exprNode
+			if _, ok := p.accept(")"); !ok {
+				for {
+					arg, err := p.parseOr()
+					if err != nil {
+						return nil, err
+					}
+					args = append(args, arg)
+					if _, ok := p.accept(","); ok {
+						continue
+					}
+					if err := p.expect(")"); err != nil {
+						return nil, err
+					}
+					break
+				}
+			}
+			return callNode{name: t.value, args: args}, nil
+		}
+		return contextNode{name: t.value}, nil
+	case tokenPunctuation:
+		if t.value == "(" {
+			node, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return node, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected %q in expression", t.value)
+}
+
+func parseNumber(value string) (float64, error) {
+	if strings.HasPrefix(strings.ToLower(strings.TrimLeft(value, "+-")), "0x") {
+		number, err := strconv.ParseInt(value, 0, 64)
+		return float64(number), err
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// -----------------------------------------------------------------------------
+// Evaluation
+// -----------------------------------------------------------------------------
+
+type exprNode interface {
+	eval(ctx Context) (any, error)
+}
+
+type literalNode struct {
+	value any
+}
+
+func (n literalNode) eval(Context) (any, error) {
+	return n.value, nil
+}
+
+type contextNode struct {
+	name string
+}
+
+func (n contextNode) eval(ctx Context) (any, error) {
+	value, ok := lookup(map[string]any(ctx), n.name)
+	if !ok {
+		return nil, fmt.Errorf("context %q is %w", n.name, ErrUnavailable)
+	}
+	return value, nil
+}
+
+// filtered is the result of a .* filter, a property of it is the property of each of its items
+type filtered []any
+
+type filterNode struct {
+	target exprNode
+}
+
+func (n filterNode) eval(ctx Context) (any, error) {
+	target, err := n.target.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := target.(type) {
+	case []any:
+		return filtered(v), nil
+	case filtered:
+		return v, nil
+	case map[string]any:
+		var values filtered
+		for _, key := range sortedKeys(v) {
+			values = append(values, v[key])
+		}
+		return values, nil
+	}
+	return filtered{}, nil
+}
+
+type indexNode struct {
+	target exprNode
+	index  exprNode
+}
+
+func (n indexNode) eval(ctx Context) (any, error) {
+	target, err := n.target.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index, err := n.index.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if items, ok := target.(filtered); ok {
+		var values filtered
+		for _, item := range items {
+			if value := property(item, index); value != nil {
+				values = append(values, value)
+			}
+		}
+		return values, nil
+	}
+
+	return property(target, index), nil
+}
+
+// property returns the property of an object or the item of an array, a missing property is null
+func property(target any, index any) any {
+	switch v := target.(type) {
+	case map[string]any:
+		value, _ := lookup(v, toString(index))
+		return value
+	case []any:
+		number := toNumber(index)
+		if i := int(number); float64(i) == number && i >= 0 && i < len(v) {
+			return v[i]
+		}
+	}
+	return nil
+}
+
+// lookup finds the key case-insensitively like GitHub does
+func lookup(m map[string]any, key string) (any, bool) {
+	if value, ok := m[key]; ok {
+		return value, true
+	}
+	for k, value := range m {
+		if strings.EqualFold(k, key) {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+type notNode struct {
+	operand exprNode
+}
+
+func (n notNode) eval(ctx Context) (any, error) {
+	value, err := n.operand.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return !truthy(value), nil
+}
+
+// logicalNode is && or ||, they evaluate to one of their operands like in JavaScript
+type logicalNode struct {
+	operator string
+	left     exprNode
+	right    exprNode
+}
+
+func (n logicalNode) eval(ctx Context) (any, error) {
+	left, err := n.left.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if truthy(left) == (n.operator == "||") {
+		return left, nil
+	}
+	return n.right.eval(ctx)
+}
+
+type compareNode struct {
+	operator string
+	left     exprNode
+	right    exprNode
+}
+
+func (n compareNode) eval(ctx Context) (any, error) {
+	left, err := n.left.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.operator {
+	case "==":
+		return looseEqual(left, right), nil
+	case "!=":
+		return !looseEqual(left, right), nil
+	}
+
+	var order int
+	leftString, leftIsString := left.(string)
+	rightString, rightIsString := right.(string)
+	if leftIsString && rightIsString {
+		order = strings.Compare(strings.ToUpper(leftString), strings.ToUpper(rightString))
+	} else {
+		a, b := toNumber(left), toNumber(right)
+		if math.IsNaN(a) || math.IsNaN(b) {
+			return false, nil
+		}
+		switch {
+		case a < b:
+			order = -1
+		case a > b:
+			order = 1
+		}
+	}
+
+	switch n.operator {
+	case "<":
+		return order < 0, nil
+	case "<=":
+		return order <= 0, nil
+	case ">":
+		return order > 0, nil
+	default:
+		return order >= 0, nil
+	}
+}
+
+// looseEqual compares values like GitHub does, values of different types are compared as numbers and strings are
+// compared case-insensitively
+func looseEqual(a, b any) bool {
+	a, b = normalizeNumbers(a), normalizeNumbers(b)
+
+	switch x := a.(type) {
+	case nil:
+		if b == nil {
+			return true
+		}
+	case bool:
+		if y, ok := b.(bool); ok {
+			return x == y
+		}
+	case float64:
+		if y, ok := b.(float64); ok {
+			return x == y
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.EqualFold(x, y)
+		}
+	case []any, map[string]any, filtered:
+		// Arrays and objects are only equal to themselves, which cannot be told apart from copies here
+		return false
+	}
+
+	switch b.(type) {
+	case []any, map[string]any, filtered:
+		return false
+	}
+	return toNumber(a) == toNumber(b)
+}
+
+func truthy(value any) bool {
+	switch v := normalizeNumbers(value).(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0 && !math.IsNaN(v)
+	case string:
+		return v != ""
+	}
+	return true
+}
+
+func toNumber(value any) float64 {
+	switch v := normalizeNumbers(value).(type) {
+	case nil:
+		return 0
+	case bool:
+		if v {
+			return 1
+		}
+		return 0
+	case float64:
+		return v
+	case string:
+		v = strings.TrimSpace(v)
+		if v == "" {
+			return 0
+		}
+		if number, err := parseNumber(v); err == nil {
+			return number
+		}
+	}
+	return math.NaN()
+}
+
+func toString(value any) string {
+	switch v := normalizeNumbers(value).(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []any, filtered:
+		return "Array"
+	case map[string]any:
+		return "Object"
+	}
+	return fmt.Sprint(value)
+}
+
+// -----------------------------------------------------------------------------
+// Functions
+// -----------------------------------------------------------------------------
+
+type callNode struct {
+	name string
+	args []exprNode
+}
+
+func (n callNode) eval(ctx Context) (any, error) {
+	var args []any
+	for _, arg := range n.args {
+		value, err := arg.eval(ctx)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, value)
+	}
+
+	name := strings.ToLower(n.name)
+	if err := checkArgs(name, len(args)); err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case "contains":
+		switch search := args[0].(type) {
+		case []any:
+			for _, item := range search {
+				if looseEqual(item, args[1]) {
+					return true, nil
+				}
+			}
+			return false, nil
+		case filtered:
+			for _, item := range search {
+				if looseEqual(item, args[1]) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		return strings.Contains(strings.ToLower(toString(args[0])), strings.ToLower(toString(args[1]))), nil
+	case "startswith":
+		return strings.HasPrefix(strings.ToLower(toString(args[0])), strings.ToLower(toString(args[1]))), nil
+	case "endswith":
+		return strings.HasSuffix(strings.ToLower(toString(args[0])), strings.ToLower(toString(args[1]))), nil
+	case "format":
+		return format(toString(args[0]), args[1:])
+	case "join":
+		separator := ","
+		if len(args) > 1 {
+			separator = toString(args[1])
+		}
+		var items []any
+		switch v := args[0].(type) {
+		case []any:
+			items = v
+		case filtered:
+			items = v
+		default:
+			return toString(v), nil
+		}
+		var values []string
+		for _, item := range items {
+			values = append(values, toString(item))
+		}
+		return strings.Join(values, separator), nil
+	case "tojson":
+		value := args[0]
+		if items, ok := value.(filtered); ok {
+			value = []any(items)
+		}
+		content, err := json.MarshalIndent(normalizeNumbers(value), "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return string(content), nil
+	case "fromjson":
+		var value any
+		if err := json.Unmarshal([]byte(toString(args[0])), &value); err != nil {
+			return nil, fmt.Errorf("fromJSON: %w", err)
+		}
+		return value, nil
+	case "success", "always":
+		// The jobs are previewed as if the jobs they need succeed
+		return true, nil
+	case "failure", "cancelled":
+		return false, nil
+	case "hashfiles":
+		return nil, fmt.Errorf("hashFiles is %w", ErrUnavailable)
+	}
+
+	return nil, fmt.Errorf("unknown function %s", n.name)
+}
+
+var functionArgs = map[string][2]int{
+	"contains":   {2, 2},
+	"startswith": {2, 2},
+	"endswith":   {2, 2},
+	"format":     {1, math.MaxInt},
+	"join":       {1, 2},
+	"tojson":     {1, 1},
+	"fromjson":   {1, 1},
+	"success":    {0, 0},
+	"always":     {0, 0},
+	"failure":    {0, 0},
+	"cancelled":  {0, 0},
+	"hashfiles":  {1, math.MaxInt},
+}
+
+func checkArgs(name string, count int) error {
+	limits, ok := functionArgs[name]
+	if !ok {
+		return fmt.Errorf("unknown function %s", name)
+	}
+	if count < limits[0] || count > limits[1] {
+		return fmt.Errorf("function %s takes %d to %d arguments, got %d", name, limits[0], limits[1], count)
+	}
+	return nil
+}
+
+// format replaces {N} with the Nth argument, {{ and }} are escaped braces
+func format(pattern string, args []any) (string, error) {
+	var result strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "{{"):
+			result.WriteByte('{')
+			i++
+		case strings.HasPrefix(pattern[i:], "}}"):
+			result.WriteByte('}')
+			i++
+		case pattern[i] == '{':
+			end := strings.IndexByte(pattern[i:], '}')
+			if end < 0 {
+				return "", fmt.Errorf("format: unclosed { in %q", pattern)
+			}
+			placeholder := pattern[i+1 : i+end]
+			index, err := strconv.Atoi(placeholder)
+			if err != nil || index < 0 || index >= len(args) {
+				return "", fmt.Errorf("format: invalid placeholder {%s} in %q", placeholder, pattern)
+			}
+			result.WriteString(toString(args[index]))
+			i += end
+		case pattern[i] == '}':
+			return "", fmt.Errorf("format: unescaped } in %q", pattern)
+		default:
+			result.WriteByte(pattern[i])
+		}
+	}
+	return result.String(), nil
+}
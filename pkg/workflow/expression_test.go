@@ -0,0 +1,132 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluate(t *testing.T) {
+	ctx := NewDispatchContext("termkit/gama", "main", map[string]any{
+		"environment": "Production",
+		"debug":       false,
+		"replicas":    float64(3),
+		"regions":     `["eu-west-1", "us-east-1"]`,
+	})
+
+	tests := []struct {
+		expression string
+		want       any
+	}{
+		{"null", nil},
+		{"true", true},
+		{"42", float64(42)},
+		{"-2.5", -2.5},
+		{"0xff", float64(255)},
+		{"1e3", float64(1000)},
+		{"'it''s'", "it's"},
+		{"inputs.environment", "Production"},
+		{"inputs['environment']", "Production"},
+		{"INPUTS.Environment", "Production"},
+		{"inputs.missing", nil},
+		{"github.ref", "refs/heads/main"},
+		{"github.ref_name", "main"},
+		{"github.event_name", "workflow_dispatch"},
+		{"github.event.inputs.replicas", "3"},
+		{"github.event.inputs.debug", "false"},
+		{"github.event.inputs.debug == 'false'", true},
+		{"inputs.environment == 'production'", true},
+		{"inputs.replicas == '3'", true},
+		{"inputs.debug == 0", true},
+		{"inputs.replicas > 2 && inputs.replicas <= 3", true},
+		{"'abc' < 'ABD'", true},
+		{"'abc' < 1", false},
+		{"!inputs.debug", true},
+		{"!(inputs.debug || inputs.replicas != 3)", true},
+		{"inputs.debug || 'fallback'", "fallback"},
+		{"inputs.environment && 'deploy'", "deploy"},
+		{"contains('Hello world', 'WORLD')", true},
+		{"contains(fromJSON(inputs.regions), 'us-east-1')", true},
+		{"contains(fromJSON(inputs.regions), 'ap-south-1')", false},
+		{"startsWith(github.ref, 'refs/heads/')", true},
+		{"endsWith(github.ref, '/MAIN')", true},
+		{"format('{0}-{1} {{x}}', inputs.environment, inputs.replicas)", "Production-3 {x}"},
+		{"fromJSON(inputs.regions)[1]", "us-east-1"},
+		{"fromJSON('[{\"name\":\"a\"},{\"name\":\"b\"}]').*.name", []any{"a", "b"}},
+		{"join(fromJSON(inputs.regions), ', ')", "eu-west-1, us-east-1"},
+		{"toJSON(fromJSON('[1]'))", "[\n  1\n]"},
+		{"success() && !failure()", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			got, err := Evaluate(tt.expression, ctx)
+			assert.NoError(t, err)
+			if items, ok := got.(filtered); ok {
+				got = []any(items)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEvaluate_Errors(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       string
+	}{
+		{"needs.build.outputs.version", `context "needs" is not available before the run`},
+		{"hashFiles('go.sum')", "hashFiles is not available before the run"},
+		{"inputs.a ==", "unexpected end of expression"},
+		{"(inputs.a", `expected ")" at the end of expression`},
+		{"'open", "unterminated string in expression"},
+		{"inputs.a = 1", `unexpected character '=' in expression`},
+		{"upper('a')", "unknown function upper"},
+		{"contains('a')", "function contains takes 2 to 2 arguments, got 1"},
+		{"format('{1}', 'a')", `format: invalid placeholder {1} in "{1}"`},
+		{"inputs.a inputs.b", `unexpected "inputs" in expression`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			_, err := Evaluate(tt.expression, Context{"inputs": map[string]any{}})
+			assert.EqualError(t, err, tt.want)
+		})
+	}
+}
+
+func TestEvaluateTemplate(t *testing.T) {
+	ctx := Context{"inputs": map[string]any{"name": "gama", "count": float64(2)}}
+
+	got, err := EvaluateTemplate("${{ inputs.count }}", ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(2), got)
+
+	got, err = EvaluateTemplate("${{ inputs.name }} x${{ inputs.count }}", ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "gama x2", got)
+
+	got, err = EvaluateTemplate("plain", ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "plain", got)
+}
+
+func TestEvaluateCondition(t *testing.T) {
+	ctx := NewDispatchContext("termkit/gama", "refs/tags/v1.0.0", nil)
+
+	tests := []struct {
+		condition string
+		want      bool
+	}{
+		{"", true},
+		{"github.ref_type == 'tag'", true},
+		{"${{ github.ref_name == 'v1.0.0' }}", true},
+		{"${{ startsWith(github.ref, 'refs/heads/') }}", false},
+		{"inputs.missing", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			got, err := EvaluateCondition(tt.condition, ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,423 @@
+package workflow
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	py "github.com/termkit/gama/pkg/yaml"
+)
+
+// Graph is the dependency graph of the jobs of a workflow, built from jobs.<job_id>.needs
+type Graph struct {
+	// Jobs is the list of job ids in declaration order
+	Jobs []string
+
+	// Needs is a map of job id and the ids of the jobs it needs
+	Needs map[string][]string
+}
+
+// NewGraph builds the graph of the jobs, it fails if a job needs an unknown job or the jobs need each other in a cycle
+func NewGraph(jobs []py.WorkflowJob) (*Graph, error) {
+	var g = &Graph{
+		Needs: make(map[string][]string),
+	}
+
+	for _, job := range jobs {
+		g.Jobs = append(g.Jobs, job.ID)
+	}
+
+	for _, job := range jobs {
+		var needs []string
+		for _, need := range job.Needs {
+			if !slices.Contains(g.Jobs, need) {
+				return nil, fmt.Errorf("job %q needs unknown job %q", job.ID, need)
+			}
+			if !slices.Contains(needs, need) {
+				needs = append(needs, need)
+			}
+		}
+		g.Needs[job.ID] = needs
+	}
+
+	if cycle := g.findCycle(); cycle != nil {
+		return nil, fmt.Errorf("jobs need each other in a cycle: %s", strings.Join(cycle, " -> "))
+	}
+
+	return g, nil
+}
+
+func (g *Graph) findCycle() []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	var state = make(map[string]int)
+	var path []string
+
+	var visit func(job string) []string
+	visit = func(job string) []string {
+		switch state[job] {
+		case visiting:
+			start := slices.Index(path, job)
+			return append(slices.Clone(path[start:]), job)
+		case visited:
+			return nil
+		}
+
+		state[job] = visiting
+		path = append(path, job)
+		for _, need := range g.Needs[job] {
+			if cycle := visit(need); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[job] = visited
+		return nil
+	}
+
+	for _, job := range g.Jobs {
+		if cycle := visit(job); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// Layers groups the jobs by the length of the longest chain of needs before them.
+// The jobs of the first layer need nothing, the jobs of a layer only need jobs of the layers before it.
+func (g *Graph) Layers() [][]string {
+	var layers [][]string
+	layerOf := g.layerOf()
+	for _, job := range g.Jobs {
+		layer := layerOf[job]
+		for len(layers) <= layer {
+			layers = append(layers, nil)
+		}
+		layers[layer] = append(layers[layer], job)
+	}
+	return layers
+}
+
+func (g *Graph) layerOf() map[string]int {
+	var layerOf = make(map[string]int)
+
+	var visit func(job string) int
+	visit = func(job string) int {
+		if layer, ok := layerOf[job]; ok {
+			return layer
+		}
+		layer := 0
+		for _, need := range g.Needs[job] {
+			layer = max(layer, visit(need)+1)
+		}
+		layerOf[job] = layer
+		return layer
+	}
+
+	for _, job := range g.Jobs {
+		visit(job)
+	}
+	return layerOf
+}
+
+// -----------------------------------------------------------------------------
+// Rendering
+// -----------------------------------------------------------------------------
+
+// maxLabelWidth is the maximum width of a job id in its box, longer ids are truncated
+const maxLabelWidth = 24
+
+// graphNode is a job in the drawing, a node without a job carries an edge which spans more than one layer
+type graphNode struct {
+	job      string
+	index    int
+	parents  []*graphNode
+	children []*graphNode
+}
+
+// Render draws the graph with box-drawing characters, the jobs flow from left to right.
+// decorate is called with the id of a job and each piece of its box, so the box can be styled, it can be nil.
+func (g *Graph) Render(decorate func(job string, text string) string) string {
+	if len(g.Jobs) == 0 {
+		return ""
+	}
+
+	layers := g.buildLayers()
+	orderLayers(layers)
+
+	// Every node takes 3 lines for its box and a line of space, edges enter and leave at the middle line
+	const nodeHeight = 4
+	middle := func(n *graphNode) int { return n.index*nodeHeight + 1 }
+
+	var height int
+	var widths, channels []int
+	for l, layer := range layers {
+		height = max(height, len(layer)*nodeHeight-1)
+
+		width := 1
+		for _, n := range layer {
+			if n.job != "" {
+				width = max(width, utf8.RuneCountInString(label(n.job))+4)
+			}
+		}
+		widths = append(widths, width)
+
+		if l < len(layers)-1 {
+			channels = append(channels, 2*len(targets(layers[l+1]))+1)
+		}
+	}
+
+	var width int
+	for l := range layers {
+		width += widths[l]
+		if l < len(channels) {
+			width += channels[l]
+		}
+	}
+
+	c := newCanvas(width, height)
+
+	x := 0
+	for l, layer := range layers {
+		for _, n := range layer {
+			if n.job == "" {
+				c.line(x, middle(n), x+widths[l]-1, middle(n))
+				continue
+			}
+			c.box(x, n.index*nodeHeight, widths[l], n)
+		}
+		x += widths[l]
+
+		if l == len(layers)-1 {
+			break
+		}
+
+		// Every target has its own vertical track in the channel, the edges from its parents meet on it
+		for k, target := range targets(layers[l+1]) {
+			track := x + 1 + 2*k
+			for _, parent := range target.parents {
+				c.line(x-1, middle(parent), track, middle(parent))
+				c.line(track, middle(parent), track, middle(target))
+			}
+			c.line(track, middle(target), x+channels[l], middle(target))
+		}
+		x += channels[l]
+	}
+
+	return c.render(decorate)
+}
+
+// buildLayers places the jobs in their layers, an edge which spans more than one layer passes through a node in
+// every layer between
+func (g *Graph) buildLayers() [][]*graphNode {
+	var layers = make([][]*graphNode, len(g.Layers()))
+	var nodes = make(map[string]*graphNode)
+
+	layerOf := g.layerOf()
+	add := func(n *graphNode, layer int) {
+		n.index = len(layers[layer])
+		layers[layer] = append(layers[layer], n)
+	}
+
+	for _, job := range g.Jobs {
+		nodes[job] = &graphNode{job: job}
+		add(nodes[job], layerOf[job])
+	}
+
+	for _, job := range g.Jobs {
+		child := nodes[job]
+		for _, need := range g.Needs[job] {
+			parent := nodes[need]
+			for layer := layerOf[need] + 1; layer < layerOf[job]; layer++ {
+				through := &graphNode{}
+				add(through, layer)
+				link(parent, through)
+				parent = through
+			}
+			link(parent, child)
+		}
+	}
+
+	return layers
+}
+
+func link(parent, child *graphNode) {
+	parent.children = append(parent.children, child)
+	child.parents = append(child.parents, parent)
+}
+
+// orderLayers orders the nodes of every layer by the average position of their neighbours to reduce crossing edges
+func orderLayers(layers [][]*graphNode) {
+	for range 4 {
+		for l := 1; l < len(layers); l++ {
+			orderByNeighbours(layers[l], func(n *graphNode) []*graphNode { return n.parents })
+		}
+		for l := len(layers) - 2; l >= 0; l-- {
+			orderByNeighbours(layers[l], func(n *graphNode) []*graphNode { return n.children })
+		}
+	}
+}
+
+func orderByNeighbours(layer []*graphNode, neighbours func(n *graphNode) []*graphNode) {
+	var positions = make(map[*graphNode]float64)
+	for _, n := range layer {
+		positions[n] = float64(n.index)
+		if adjacent := neighbours(n); len(adjacent) > 0 {
+			var sum int
+			for _, a := range adjacent {
+				sum += a.index
+			}
+			positions[n] = float64(sum) / float64(len(adjacent))
+		}
+	}
+
+	slices.SortStableFunc(layer, func(a, b *graphNode) int {
+		return cmp.Compare(positions[a], positions[b])
+	})
+	for i, n := range layer {
+		n.index = i
+	}
+}
+
+// targets returns the nodes of the layer which have parents
+func targets(layer []*graphNode) []*graphNode {
+	var result []*graphNode
+	for _, n := range layer {
+		if len(n.parents) > 0 {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+func label(job string) string {
+	if utf8.RuneCountInString(job) <= maxLabelWidth {
+		return job
+	}
+	return string([]rune(job)[:maxLabelWidth-1]) + "…"
+}
+
+// -----------------------------------------------------------------------------
+// Canvas
+// -----------------------------------------------------------------------------
+
+// Directions a line leaves a cell to, the box-drawing character of a cell is chosen from them
+const (
+	up = 1 << iota
+	down
+	left
+	right
+)
+
+var lineRunes = map[int]rune{
+	up: '│', down: '│', up | down: '│',
+	left: '─', right: '─', left | right: '─',
+	down | right: '┌', down | left: '┐', up | right: '└', up | left: '┘',
+	up | down | right: '├', up | down | left: '┤', left | right | down: '┬', left | right | up: '┴',
+	up | down | left | right: '┼',
+}
+
+type cell struct {
+	r    rune // drawn rune, lines are drawn from the directions when it is zero
+	dirs int
+	job  string // job which owns the cell
+}
+
+type canvas struct {
+	cells [][]cell
+}
+
+func newCanvas(width, height int) *canvas {
+	var c = &canvas{cells: make([][]cell, height)}
+	for y := range c.cells {
+		c.cells[y] = make([]cell, width)
+	}
+	return c
+}
+
+// line draws a horizontal or vertical line between two cells
+func (c *canvas) line(x1, y1, x2, y2 int) {
+	switch {
+	case y1 == y2 && x1 != x2:
+		x1, x2 = min(x1, x2), max(x1, x2)
+		for x := x1; x <= x2; x++ {
+			if x > x1 {
+				c.cells[y1][x].dirs |= left
+			}
+			if x < x2 {
+				c.cells[y1][x].dirs |= right
+			}
+		}
+	case x1 == x2 && y1 != y2:
+		y1, y2 = min(y1, y2), max(y1, y2)
+		for y := y1; y <= y2; y++ {
+			if y > y1 {
+				c.cells[y][x1].dirs |= up
+			}
+			if y < y2 {
+				c.cells[y][x1].dirs |= down
+			}
+		}
+	}
+}
+
+func (c *canvas) box(x, y, width int, n *graphNode) {
+	text := label(n.job)
+	rows := []string{
+		"┌" + strings.Repeat("─", width-2) + "┐",
+		"│ " + text + strings.Repeat(" ", width-4-utf8.RuneCountInString(text)) + " │",
+		"└" + strings.Repeat("─", width-2) + "┘",
+	}
+
+	// The middle line shows where the edges enter and leave the box
+	if len(n.parents) > 0 {
+		rows[1] = "┤" + strings.TrimPrefix(rows[1], "│")
+	}
+	if len(n.children) > 0 {
+		rows[1] = strings.TrimSuffix(rows[1], "│") + "├"
+	}
+
+	for dy, row := range rows {
+		for dx, r := range []rune(row) {
+			c.cells[y+dy][x+dx] = cell{r: r, job: n.job}
+		}
+	}
+}
+
+func (c *canvas) render(decorate func(job string, text string) string) string {
+	var lines []string
+	for _, row := range c.cells {
+		var line strings.Builder
+		for start := 0; start < len(row); {
+			end := start
+			var piece strings.Builder
+			for end < len(row) && row[end].job == row[start].job {
+				r := row[end].r
+				if r == 0 {
+					r = ' '
+					if lineRune, ok := lineRunes[row[end].dirs]; ok {
+						r = lineRune
+					}
+				}
+				piece.WriteRune(r)
+				end++
+			}
+
+			if row[start].job != "" && decorate != nil {
+				line.WriteString(decorate(row[start].job, piece.String()))
+			} else {
+				line.WriteString(piece.String())
+			}
+			start = end
+		}
+		lines = append(lines, strings.TrimRight(line.String(), " "))
+	}
+	// The last layer can end with a node without a box, so the empty lines below it are dropped
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
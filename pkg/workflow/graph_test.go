@@ -0,0 +1,86 @@
+package workflow
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	py "github.com/termkit/gama/pkg/yaml"
+)
+
+func TestNewGraph(t *testing.T) {
+	graph, err := NewGraph([]py.WorkflowJob{
+		{ID: "lint"},
+		{ID: "test"},
+		{ID: "build", Needs: []string{"lint", "test", "lint"}},
+		{ID: "deploy", Needs: []string{"build", "lint"}},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"lint", "test", "build", "deploy"}, graph.Jobs)
+	assert.Equal(t, []string{"lint", "test"}, graph.Needs["build"])
+	assert.Equal(t, [][]string{{"lint", "test"}, {"build"}, {"deploy"}}, graph.Layers())
+}
+
+func TestNewGraph_Invalid(t *testing.T) {
+	_, err := NewGraph([]py.WorkflowJob{
+		{ID: "build", Needs: []string{"setup"}},
+	})
+	assert.EqualError(t, err, `job "build" needs unknown job "setup"`)
+
+	_, err = NewGraph([]py.WorkflowJob{
+		{ID: "a", Needs: []string{"c"}},
+		{ID: "b", Needs: []string{"a"}},
+		{ID: "c", Needs: []string{"b"}},
+	})
+	assert.EqualError(t, err, "jobs need each other in a cycle: a -> c -> b -> a")
+}
+
+func TestGraph_Render(t *testing.T) {
+	graph, err := NewGraph([]py.WorkflowJob{
+		{ID: "lint"},
+		{ID: "test"},
+		{ID: "build", Needs: []string{"lint", "test"}},
+	})
+	assert.NoError(t, err)
+
+	want := strings.Join([]string{
+		"┌──────┐   ┌───────┐",
+		"│ lint ├─┬─┤ build │",
+		"└──────┘ │ └───────┘",
+		"         │",
+		"┌──────┐ │",
+		"│ test ├─┘",
+		"└──────┘",
+	}, "\n")
+	assert.Equal(t, want, graph.Render(nil))
+}
+
+func TestGraph_Render_SkipsLayers(t *testing.T) {
+	graph, err := NewGraph([]py.WorkflowJob{
+		{ID: "a"},
+		{ID: "b", Needs: []string{"a"}},
+		{ID: "c", Needs: []string{"a", "b"}},
+	})
+	assert.NoError(t, err)
+
+	want := strings.Join([]string{
+		"┌───┐     ┌───┐   ┌───┐",
+		"│ a ├───┬─┤ b ├─┬─┤ c │",
+		"└───┘   │ └───┘ │ └───┘",
+		"        │       │",
+		"        │       │",
+		"        └───────┘",
+	}, "\n")
+	assert.Equal(t, want, graph.Render(nil))
+}
+
+func TestGraph_Render_Decorate(t *testing.T) {
+	graph, err := NewGraph([]py.WorkflowJob{{ID: "build"}})
+	assert.NoError(t, err)
+
+	rendered := graph.Render(func(job string, text string) string {
+		return "<" + job + ">" + text
+	})
+	assert.Equal(t, "<build>┌───────┐\n<build>│ build │\n<build>└───────┘", rendered)
+}
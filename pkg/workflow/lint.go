@@ -0,0 +1,162 @@
+package workflow
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	py "github.com/termkit/gama/pkg/yaml"
+)
+
+// conditionPattern matches an if key whose condition is written without ${{ }}
+var conditionPattern = regexp.MustCompile(`^(\s*(?:-\s+)?if:\s*)(.*?)\s*$`)
+
+// Lint checks a workflow file offline, the findings of py.Lint are extended with the ones of the expressions: syntax
+// errors and references to inputs which are not declared by workflow_dispatch or workflow_call
+func Lint(data []byte) []py.Finding {
+	findings := py.Lint(data)
+
+	// Expressions are only checked in a workflow which can be parsed, otherwise the syntax finding is enough
+	content, err := py.UnmarshalWorkflowContent(data)
+	if err != nil {
+		return findings
+	}
+
+	var declared []string
+	for _, dispatch := range []py.WorkflowDispatch{content.On.WorkflowDispatch, content.On.WorkflowCall} {
+		for name := range dispatch.Inputs {
+			declared = append(declared, strings.ToLower(name))
+		}
+	}
+
+	for i, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+
+		for _, expression := range lineExpressions(line) {
+			node, err := parseExpression(expression.text)
+			if err != nil {
+				findings = append(findings, py.Finding{Line: i + 1, Column: expression.column, Rule: "expression",
+					Message: fmt.Sprintf("invalid expression %q: %v", strings.TrimSpace(expression.text), err)})
+				continue
+			}
+
+			for _, input := range inputReferences(node) {
+				if !slices.Contains(declared, strings.ToLower(input)) {
+					findings = append(findings, py.Finding{Line: i + 1, Column: expression.column, Rule: "undeclared-input",
+						Message: fmt.Sprintf("input %s is not declared", input)})
+				}
+			}
+		}
+	}
+
+	slices.SortStableFunc(findings, func(a, b py.Finding) int {
+		if a.Line != b.Line {
+			return a.Line - b.Line
+		}
+		return a.Column - b.Column
+	})
+	return findings
+}
+
+type lineExpression struct {
+	text   string
+	column int
+}
+
+// lineExpressions returns the ${{ }} expressions of a line and the condition of an if key written without them
+func lineExpressions(line string) []lineExpression {
+	var expressions []lineExpression
+	for _, match := range templatePattern.FindAllStringSubmatchIndex(line, -1) {
+		expressions = append(expressions, lineExpression{text: line[match[2]:match[3]], column: match[0] + 1})
+	}
+	if len(expressions) > 0 {
+		return expressions
+	}
+
+	match := conditionPattern.FindStringSubmatch(line)
+	if match == nil {
+		return nil
+	}
+	condition, _, _ := strings.Cut(match[2], " #")
+	condition = strings.TrimSpace(condition)
+	if condition == "" || strings.ContainsAny(condition[:1], "|>") {
+		// Block scalars span lines, they are not checked
+		return nil
+	}
+	if len(condition) >= 2 && (condition[0] == '"' || condition[0] == '\'') && condition[len(condition)-1] == condition[0] {
+		condition = condition[1 : len(condition)-1]
+	}
+	return []lineExpression{{text: condition, column: len(match[1]) + 1}}
+}
+
+// inputReferences returns the names of the inputs read by the expression as inputs.<name> or
+// github.event.inputs.<name>, inputs read with a computed index are not known
+func inputReferences(node exprNode) []string {
+	var names []string
+	walkExpression(node, func(n exprNode) {
+		index, ok := n.(indexNode)
+		if !ok {
+			return
+		}
+		name, ok := propertyName(index)
+		if !ok || !isInputsContext(index.target) {
+			return
+		}
+		names = append(names, name)
+	})
+	return names
+}
+
+func isInputsContext(node exprNode) bool {
+	switch n := node.(type) {
+	case contextNode:
+		return strings.EqualFold(n.name, "inputs")
+	case indexNode:
+		// github.event.inputs
+		property, _ := propertyName(n)
+		event, ok := n.target.(indexNode)
+		if !ok || !strings.EqualFold(property, "inputs") {
+			return false
+		}
+		eventProperty, _ := propertyName(event)
+		github, ok := event.target.(contextNode)
+		return ok && strings.EqualFold(eventProperty, "event") && strings.EqualFold(github.name, "github")
+	}
+	return false
+}
+
+// propertyName returns the name of a property read with a constant, like a.name or a['name']
+func propertyName(node indexNode) (string, bool) {
+	literal, ok := node.index.(literalNode)
+	if !ok {
+		return "", false
+	}
+	name, ok := literal.value.(string)
+	return name, ok
+}
+
+func walkExpression(node exprNode, visit func(exprNode)) {
+	visit(node)
+	switch n := node.(type) {
+	case filterNode:
+		walkExpression(n.target, visit)
+	case indexNode:
+		walkExpression(n.target, visit)
+		walkExpression(n.index, visit)
+	case notNode:
+		walkExpression(n.operand, visit)
+	case logicalNode:
+		walkExpression(n.left, visit)
+		walkExpression(n.right, visit)
+	case compareNode:
+		walkExpression(n.left, visit)
+		walkExpression(n.right, visit)
+	case callNode:
+		for _, arg := range n.args {
+			walkExpression(arg, visit)
+		}
+	}
+}
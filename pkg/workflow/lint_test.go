@@ -0,0 +1,47 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLint(t *testing.T) {
+	data := []byte(`on:
+  workflow_dispatch:
+    inputs:
+      environment:
+        type: choice
+        options: [staging, production]
+  workflow_call:
+    inputs:
+      version:
+        type: string
+jobs:
+  deploy:
+    if: inputs.environment == 'production' && inputs.dry_run
+    runs-on: ubuntu-latest
+    steps:
+      # echo ${{ inputs.commented }}
+      - run: echo ${{ inputs.version }} ${{ github.event.inputs.region }}
+      - if: ${{ inputs.environment == }}
+        run: echo ${{ inputs['Environment'] }}
+`)
+
+	var got []string
+	for _, finding := range Lint(data) {
+		got = append(got, finding.String())
+	}
+
+	assert.Equal(t, []string{
+		"13:9: input dry_run is not declared (undeclared-input)",
+		"17:41: input region is not declared (undeclared-input)",
+		`18:13: invalid expression "inputs.environment ==": unexpected end of expression (expression)`,
+	}, got)
+}
+
+func TestLint_StructureFindings(t *testing.T) {
+	findings := Lint([]byte("on: push\njobs:\n  test:\n    needs: build\n"))
+	assert.Len(t, findings, 1)
+	assert.Equal(t, "needs", findings[0].Rule)
+}
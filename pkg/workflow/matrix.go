@@ -0,0 +1,383 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	py "github.com/termkit/gama/pkg/yaml"
+)
+
+// MaxMatrixJobs is the maximum number of jobs a matrix can generate per workflow run
+const MaxMatrixJobs = 256
+
+// MatrixEntry is a variable of a matrix combination and its value
+type MatrixEntry struct {
+	Key   string
+	Value any
+}
+
+// MatrixCombination is a combination of matrix variables, the axes come first in declaration order
+type MatrixCombination []MatrixEntry
+
+func (c MatrixCombination) String() string {
+	var entries []string
+	for _, entry := range c {
+		entries = append(entries, fmt.Sprintf("%s=%s", entry.Key, formatMatrixValue(entry.Value)))
+	}
+	return strings.Join(entries, ", ")
+}
+
+func (c MatrixCombination) get(key string) (any, bool) {
+	for _, entry := range c {
+		if entry.Key == key {
+			return entry.Value, true
+		}
+	}
+	return nil, false
+}
+
+func (c MatrixCombination) set(key string, value any) MatrixCombination {
+	for i, entry := range c {
+		if entry.Key == key {
+			c[i].Value = value
+			return c
+		}
+	}
+	return append(c, MatrixEntry{Key: key, Value: value})
+}
+
+// JobPreview is what a job of the workflow runs as
+type JobPreview struct {
+	Job string
+
+	// Combinations is the expanded matrix of the job, it is nil if the job has no matrix
+	Combinations []MatrixCombination
+
+	// Count is the number of jobs the job runs as, a job without a matrix runs once and a skipped job does not run
+	Count int
+
+	// Err is set if the matrix cannot be expanded, like an expression which needs the outputs of another job
+	Err error
+
+	// Skipped is set if the if condition of the job is false or a job it needs is skipped, SkipReason tells which
+	Skipped    bool
+	SkipReason string
+
+	// ConditionErr is set if the if condition cannot be evaluated before the run, the job is taken as it runs
+	ConditionErr error
+}
+
+// PreviewJobs tells which jobs a run would start with the given contexts and expands their matrices. The jobs
+// are previewed as if the jobs they need succeed.
+func PreviewJobs(jobs []py.WorkflowJob, ctx Context) []JobPreview {
+	var visiting = make(map[string]bool)
+	var byID = make(map[string]py.WorkflowJob)
+	for _, job := range jobs {
+		byID[job.ID] = job
+	}
+
+	var previews = make(map[string]*JobPreview)
+	var preview func(job py.WorkflowJob) *JobPreview
+	preview = func(job py.WorkflowJob) *JobPreview {
+		if p, ok := previews[job.ID]; ok {
+			return p
+		}
+
+		p := &JobPreview{Job: job.ID}
+		previews[job.ID] = p
+		visiting[job.ID] = true
+		defer delete(visiting, job.ID)
+
+		// A job which needs a skipped job is skipped too, unless its condition asks for a status function
+		if !hasStatusFunction(job.If) {
+			for _, need := range job.Needs {
+				needed, ok := byID[need]
+				if !ok || visiting[need] {
+					continue
+				}
+				if preview(needed).Skipped {
+					p.Skipped, p.SkipReason = true, fmt.Sprintf("needs %s which is skipped", need)
+					break
+				}
+			}
+		}
+
+		if !p.Skipped && job.If != "" {
+			run, err := EvaluateCondition(job.If, ctx)
+			switch {
+			case err != nil:
+				p.ConditionErr = err
+			case !run:
+				p.Skipped, p.SkipReason = true, fmt.Sprintf("if: %s is false", strings.TrimSpace(job.If))
+			}
+		}
+
+		if !p.Skipped {
+			p.Count = 1
+			if job.Matrix != nil {
+				p.Combinations, p.Err = ExpandMatrix(job.Matrix, job.MatrixAxes, ctx)
+				p.Count = len(p.Combinations)
+			}
+		}
+		return p
+	}
+
+	var result []JobPreview
+	for _, job := range jobs {
+		result = append(result, *preview(job))
+	}
+	return result
+}
+
+// ExpandMatrix expands a strategy.matrix like GitHub does. The axes are multiplied, then the combinations which
+// match an exclude are removed. The values of an include are added to every combination whose axes they don't
+// overwrite, an include which cannot be added to any combination becomes a new combination.
+// axes is the declaration order of the axes, the ${{ }} expressions are evaluated with the given contexts.
+func ExpandMatrix(matrix any, axes []string, ctx Context) ([]MatrixCombination, error) {
+	value, err := substitute(matrix, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	content, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("matrix must be a mapping, got %s", formatMatrixValue(value))
+	}
+
+	// The order of the axes is lost when the matrix comes from an expression, the rest is sorted
+	var order []string
+	for _, axis := range axes {
+		if _, ok := content[axis]; ok {
+			order = append(order, axis)
+		}
+	}
+	for _, key := range sortedKeys(content) {
+		if key != "include" && key != "exclude" && !slices.Contains(order, key) {
+			order = append(order, key)
+		}
+	}
+
+	var combinations []MatrixCombination
+	for i, axis := range order {
+		values, ok := content[axis].([]any)
+		if !ok {
+			return nil, fmt.Errorf("matrix axis %s must be a list", axis)
+		}
+		if len(values) == 0 {
+			return nil, fmt.Errorf("matrix axis %s does not contain any values", axis)
+		}
+
+		if i == 0 {
+			combinations = []MatrixCombination{nil}
+		}
+		var product []MatrixCombination
+		for _, combination := range combinations {
+			for _, v := range values {
+				product = append(product, append(slices.Clone(combination), MatrixEntry{Key: axis, Value: v}))
+			}
+		}
+		combinations = product
+	}
+
+	excludes, err := matrixObjects(content, "exclude")
+	if err != nil {
+		return nil, err
+	}
+	combinations = slices.DeleteFunc(combinations, func(combination MatrixCombination) bool {
+		return slices.ContainsFunc(excludes, func(exclude map[string]any) bool {
+			return matchesExclude(combination, exclude)
+		})
+	})
+
+	includes, err := matrixObjects(content, "include")
+	if err != nil {
+		return nil, err
+	}
+	original := len(combinations)
+	for _, include := range includes {
+		keys := sortedKeys(include)
+
+		var added bool
+		for i := range original {
+			if !canInclude(combinations[i], include, order) {
+				continue
+			}
+			for _, key := range keys {
+				combinations[i] = combinations[i].set(key, include[key])
+			}
+			added = true
+		}
+
+		if !added {
+			var combination MatrixCombination
+			for _, key := range keys {
+				combination = combination.set(key, include[key])
+			}
+			combinations = append(combinations, combination)
+		}
+	}
+
+	if len(combinations) == 0 {
+		return nil, fmt.Errorf("matrix does not contain any combinations")
+	}
+	if len(combinations) > MaxMatrixJobs {
+		return combinations, fmt.Errorf("matrix generates %d jobs, at most %d are allowed", len(combinations), MaxMatrixJobs)
+	}
+
+	return combinations, nil
+}
+
+func matrixObjects(content map[string]any, key string) ([]map[string]any, error) {
+	value, ok := content[key]
+	if !ok || value == nil {
+		return nil, nil
+	}
+
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("matrix %s must be a list", key)
+	}
+
+	var objects []map[string]any
+	for _, item := range list {
+		object, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("matrix %s must be a list of mappings", key)
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+// matchesExclude reports whether the combination matches every value of the exclude, an exclude can be partial
+func matchesExclude(combination MatrixCombination, exclude map[string]any) bool {
+	for key, value := range exclude {
+		current, ok := combination.get(key)
+		if !ok || !partialEqual(current, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// canInclude reports whether the include doesn't overwrite any of the axes of the combination
+func canInclude(combination MatrixCombination, include map[string]any, axes []string) bool {
+	for key, value := range include {
+		if !slices.Contains(axes, key) {
+			continue
+		}
+		if current, _ := combination.get(key); !matrixEqual(current, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// partialEqual reports whether the value contains the pattern, a mapping matches if its values match the pattern
+func partialEqual(value, pattern any) bool {
+	patternObject, ok := pattern.(map[string]any)
+	if !ok {
+		return matrixEqual(value, pattern)
+	}
+
+	object, ok := value.(map[string]any)
+	if !ok {
+		return false
+	}
+	for key, v := range patternObject {
+		if !partialEqual(object[key], v) {
+			return false
+		}
+	}
+	return true
+}
+
+// matrixEqual compares values decoded from YAML and JSON, numbers are equal whatever their Go types are
+func matrixEqual(a, b any) bool {
+	return reflect.DeepEqual(normalizeNumbers(a), normalizeNumbers(b))
+}
+
+func normalizeNumbers(value any) any {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case []any:
+		var result = make([]any, len(v))
+		for i, item := range v {
+			result[i] = normalizeNumbers(item)
+		}
+		return result
+	case map[string]any:
+		var result = make(map[string]any, len(v))
+		for key, item := range v {
+			result[key] = normalizeNumbers(item)
+		}
+		return result
+	}
+	return value
+}
+
+func formatMatrixValue(value any) string {
+	switch v := normalizeNumbers(value).(type) {
+	case nil:
+		return "null"
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		content, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(content)
+	}
+}
+
+func sortedKeys(m map[string]any) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// substitute evaluates the expressions in the strings of the value
+func substitute(value any, ctx Context) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return EvaluateTemplate(v, ctx)
+	case []any:
+		var result = make([]any, len(v))
+		for i, item := range v {
+			substituted, err := substitute(item, ctx)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = substituted
+		}
+		return result, nil
+	case map[string]any:
+		var result = make(map[string]any, len(v))
+		for key, item := range v {
+			substituted, err := substitute(item, ctx)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = substituted
+		}
+		return result, nil
+	}
+	return value, nil
+}
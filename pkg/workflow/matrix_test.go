@@ -0,0 +1,200 @@
+package workflow
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	py "github.com/termkit/gama/pkg/yaml"
+)
+
+func combinationStrings(combinations []MatrixCombination) []string {
+	var result []string
+	for _, combination := range combinations {
+		result = append(result, combination.String())
+	}
+	return result
+}
+
+func TestExpandMatrix(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		inputs map[string]any
+		want   []string
+	}{
+		{
+			name: "axes",
+			data: `
+strategy:
+  matrix:
+    os: [ubuntu-latest, windows-latest]
+    go: [1.23, 1.24]
+`,
+			want: []string{
+				"os=ubuntu-latest, go=1.23",
+				"os=ubuntu-latest, go=1.24",
+				"os=windows-latest, go=1.23",
+				"os=windows-latest, go=1.24",
+			},
+		},
+		{
+			name: "exclude",
+			data: `
+strategy:
+  matrix:
+    os: [ubuntu-latest, windows-latest]
+    go: [1.23, 1.24]
+    exclude:
+      - os: windows-latest
+        go: 1.23
+`,
+			want: []string{
+				"os=ubuntu-latest, go=1.23",
+				"os=ubuntu-latest, go=1.24",
+				"os=windows-latest, go=1.24",
+			},
+		},
+		{
+			name: "include",
+			data: `
+strategy:
+  matrix:
+    fruit: [apple, pear]
+    animal: [cat, dog]
+    include:
+      - color: green
+      - color: pink
+        animal: cat
+      - fruit: apple
+        shape: circle
+      - fruit: banana
+      - fruit: banana
+        animal: cat
+`,
+			want: []string{
+				"fruit=apple, animal=cat, color=pink, shape=circle",
+				"fruit=apple, animal=dog, color=green, shape=circle",
+				"fruit=pear, animal=cat, color=pink",
+				"fruit=pear, animal=dog, color=green",
+				"fruit=banana",
+				"animal=cat, fruit=banana",
+			},
+		},
+		{
+			name: "inputs",
+			data: `
+strategy:
+  matrix:
+    environment: ["${{ inputs.environment }}"]
+    region: ${{ fromJSON(inputs.regions) }}
+    exclude:
+      - environment: staging
+        region: us-east-1
+`,
+			inputs: map[string]any{"environment": "staging", "regions": `["eu-west-1", "us-east-1"]`},
+			want: []string{
+				"environment=staging, region=eu-west-1",
+			},
+		},
+		{
+			name: "matrix from an input",
+			data: `
+strategy:
+  matrix: ${{ fromJSON(inputs.matrix) }}
+`,
+			inputs: map[string]any{"matrix": `{"node": [20, 22], "include": [{"node": 22, "lts": true}]}`},
+			want: []string{
+				"node=20",
+				"node=22, lts=true",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workflow, err := py.UnmarshalWorkflowContent([]byte("jobs:\n  build:\n" + indent(tt.data)))
+			assert.NoError(t, err)
+
+			job := workflow.Jobs[0]
+			combinations, err := ExpandMatrix(job.Matrix, job.MatrixAxes, Context{"inputs": tt.inputs})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, combinationStrings(combinations))
+		})
+	}
+}
+
+func TestExpandMatrix_Errors(t *testing.T) {
+	_, err := ExpandMatrix(map[string]any{"os": []any{}}, []string{"os"}, nil)
+	assert.EqualError(t, err, "matrix axis os does not contain any values")
+
+	_, err = ExpandMatrix("${{ fromJSON(needs.setup.outputs.matrix) }}", nil, nil)
+	assert.EqualError(t, err, `context "needs" is not available before the run`)
+	assert.ErrorIs(t, err, ErrUnavailable)
+
+	_, err = ExpandMatrix(map[string]any{"os": []any{"ubuntu-latest"}, "exclude": []any{map[string]any{"os": "ubuntu-latest"}}}, nil, nil)
+	assert.EqualError(t, err, "matrix does not contain any combinations")
+
+	var values []any
+	for i := range 20 {
+		values = append(values, i)
+	}
+	combinations, err := ExpandMatrix(map[string]any{"a": values, "b": values}, []string{"a", "b"}, nil)
+	assert.EqualError(t, err, "matrix generates 400 jobs, at most 256 are allowed")
+	assert.Len(t, combinations, 400)
+}
+
+func TestPreviewJobs(t *testing.T) {
+	previews := PreviewJobs([]py.WorkflowJob{
+		{ID: "lint"},
+		{ID: "test", Matrix: map[string]any{"go": []any{"1.23", "1.24"}}, MatrixAxes: []string{"go"}},
+	}, nil)
+
+	assert.Len(t, previews, 2)
+	assert.Equal(t, 1, previews[0].Count)
+	assert.Nil(t, previews[0].Combinations)
+	assert.Equal(t, 2, previews[1].Count)
+	assert.Equal(t, []string{"go=1.23", "go=1.24"}, combinationStrings(previews[1].Combinations))
+}
+
+func TestPreviewJobs_Conditions(t *testing.T) {
+	ctx := NewDispatchContext("termkit/gama", "feature", map[string]any{"dry-run": true, "target": "staging"})
+
+	previews := PreviewJobs([]py.WorkflowJob{
+		{ID: "build"},
+		{ID: "deploy", Needs: []string{"build"}, If: "${{ !inputs.dry-run && github.ref == 'refs/heads/main' }}",
+			Matrix: map[string]any{"region": []any{"eu", "us"}}, MatrixAxes: []string{"region"}},
+		{ID: "smoke", Needs: []string{"deploy"}},
+		{ID: "notify", Needs: []string{"deploy"}, If: "always()"},
+		{ID: "staging", If: "inputs.target == 'STAGING'"},
+		{ID: "promote", Needs: []string{"build"}, If: "needs.build.outputs.promote == 'true'"},
+	}, ctx)
+
+	assert.Len(t, previews, 6)
+	assert.False(t, previews[0].Skipped)
+
+	assert.True(t, previews[1].Skipped)
+	assert.Equal(t, "if: ${{ !inputs.dry-run && github.ref == 'refs/heads/main' }} is false", previews[1].SkipReason)
+	assert.Equal(t, 0, previews[1].Count)
+	assert.Nil(t, previews[1].Combinations)
+
+	assert.True(t, previews[2].Skipped)
+	assert.Equal(t, "needs deploy which is skipped", previews[2].SkipReason)
+
+	assert.False(t, previews[3].Skipped)
+	assert.False(t, previews[4].Skipped)
+
+	assert.False(t, previews[5].Skipped)
+	assert.Equal(t, 1, previews[5].Count)
+	assert.ErrorIs(t, previews[5].ConditionErr, ErrUnavailable)
+}
+
+// indent nests the data under a job
+func indent(data string) string {
+	lines := strings.Split(strings.TrimPrefix(data, "\n"), "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = "    " + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -2,8 +2,15 @@ package workflow
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
 	"reflect"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 
 	py "github.com/termkit/gama/pkg/yaml"
 )
@@ -11,6 +18,9 @@ import (
 type Workflow struct {
 	// Content is a map of key and value designed for workflow_dispatch.inputs
 	Content map[string]Content
+
+	// Keys is the list of Content keys in declaration order
+	Keys []string
 }
 
 type Content struct {
@@ -18,9 +28,15 @@ type Content struct {
 	Type        string
 	Required    bool
 
+	// InputType is the type declared in the workflow file, like string, choice, boolean, etc.
+	InputType string
+
 	// KeyValue is a map of key and value designed for JSONContent
 	KeyValue *[]KeyValue
 
+	// JSON is the tree of JSONContent, KeyValue holds its leaves in the same order
+	JSON *py.JSONNode
+
 	// Choice is a map of key and value designed for Options
 	Choice *Choice
 
@@ -32,9 +48,10 @@ type Content struct {
 }
 
 type KeyValue struct {
-	Default string
-	Key     string
-	Value   string
+	Default  string
+	Key      string
+	Value    string
+	JSONType string
 }
 
 type Value struct {
@@ -54,14 +71,17 @@ func ParseWorkflow(content py.WorkflowContent) (*Workflow, error) {
 		Content: make(map[string]Content),
 	}
 
-	for key, value := range content.On.WorkflowDispatch.Inputs {
-		if len(value.JSONContent) > 0 {
+	for _, key := range inputKeys(content.On.WorkflowDispatch) {
+		value := content.On.WorkflowDispatch.Inputs[key]
+
+		if value.JSONContent != nil {
 			var keyValue []KeyValue
-			for k, v := range value.JSONContent {
+			for _, leaf := range value.JSONContent.Leaves() {
 				keyValue = append(keyValue, KeyValue{
-					Key:     k,
-					Value:   "",
-					Default: v,
+					Key:      leaf.Path,
+					Value:    "",
+					Default:  leaf.Node.Value,
+					JSONType: string(leaf.Node.Kind),
 				})
 			}
 
@@ -69,8 +89,11 @@ func ParseWorkflow(content py.WorkflowContent) (*Workflow, error) {
 				Description: value.Description,
 				Type:        "json",
 				Required:    value.Required,
+				InputType:   inputType(value),
 				KeyValue:    &keyValue,
+				JSON:        value.JSONContent,
 			}
+			w.Keys = append(w.Keys, key)
 			continue // Skip the rest of the loop
 		}
 
@@ -79,9 +102,15 @@ func ParseWorkflow(content py.WorkflowContent) (*Workflow, error) {
 			w.Content[key] = parseChoiceTypes(value)
 		case "boolean":
 			w.Content[key] = parseBooleanTypes(value)
-		case "string", "number", "environment", "":
+		case "number":
+			w.Content[key] = parseNumberTypes(value)
+		case "environment":
+			w.Content[key] = parseEnvironmentTypes(value)
+		default:
+			// Strings and the types which are not known are typed as strings
 			w.Content[key] = parseInputTypes(value)
 		}
+		w.Keys = append(w.Keys, key)
 	}
 
 	return w, nil
@@ -90,45 +119,77 @@ func ParseWorkflow(content py.WorkflowContent) (*Workflow, error) {
 func (w *Workflow) ToPretty() *Pretty {
 	var pretty Pretty
 	var id int
-	for parent, data := range w.Content {
+
+	for _, parent := range w.Keys {
+		data, ok := w.Content[parent]
+		if !ok {
+			continue
+		}
 		if data.KeyValue != nil {
-			for _, v := range *data.KeyValue {
-				pretty.KeyVals = append(pretty.KeyVals, PrettyKeyValue{
-					ID:      id,
-					Parent:  stringPtr(parent),
-					Key:     v.Key,
-					Value:   "",
-					Default: v.Default,
-				})
+			// Every Pretty gets its own copy of the tree, values are written into it
+			var root *py.JSONNode
+			var leaves []py.JSONLeaf
+			if data.JSON != nil {
+				root = data.JSON.Clone()
+				leaves = root.Leaves()
+			}
+
+			for i, v := range *data.KeyValue {
+				keyValue := PrettyKeyValue{
+					ID:          id,
+					Parent:      stringPtr(parent),
+					Key:         v.Key,
+					Value:       "",
+					Default:     v.Default,
+					Description: data.Description,
+					Type:        data.InputType,
+					Required:    data.Required,
+					JSONType:    v.JSONType,
+				}
+				if i < len(leaves) {
+					keyValue.root = root
+					keyValue.node = leaves[i].Node
+				}
+
+				pretty.KeyVals = append(pretty.KeyVals, keyValue)
 				id++
 			}
 		}
 		if data.Choice != nil {
 			pretty.Choices = append(pretty.Choices, PrettyChoice{
-				ID:      id,
-				Key:     parent,
-				Value:   "",
-				Values:  data.Choice.Options,
-				Default: data.Choice.Default,
+				ID:          id,
+				Key:         parent,
+				Value:       "",
+				Values:      data.Choice.Options,
+				Default:     data.Choice.Default,
+				Description: data.Description,
+				Type:        data.InputType,
+				Required:    data.Required,
 			})
 			id++
 		}
 		if data.Value != nil {
 			pretty.Inputs = append(pretty.Inputs, PrettyInput{
-				ID:      id,
-				Key:     parent,
-				Value:   "",
-				Default: data.Value.Default.(string),
+				ID:          id,
+				Key:         parent,
+				Value:       "",
+				Default:     data.Value.Default.(string),
+				Description: data.Description,
+				Type:        data.InputType,
+				Required:    data.Required,
 			})
 			id++
 		}
 		if data.Boolean != nil {
 			pretty.Boolean = append(pretty.Boolean, PrettyInput{
-				ID:      id,
-				Key:     parent,
-				Value:   "",
-				Values:  data.Boolean.Options,
-				Default: data.Boolean.Default.(string),
+				ID:          id,
+				Key:         parent,
+				Value:       "",
+				Values:      data.Boolean.Options,
+				Default:     data.Boolean.Default.(string),
+				Description: data.Description,
+				Type:        data.InputType,
+				Required:    data.Required,
 			})
 			id++
 		}
@@ -137,13 +198,100 @@ func (w *Workflow) ToPretty() *Pretty {
 	return &pretty
 }
 
+// HasEnvironments reports whether any of the inputs is an environment input
+func (p *Pretty) HasEnvironments() bool {
+	for _, c := range p.Choices {
+		if c.Type == "environment" {
+			return true
+		}
+	}
+	return false
+}
+
+// SetEnvironments fills the options of environment inputs with the given environments.
+// If there are no environments to choose from, environment inputs fall back to free text inputs.
+// A default which is not one of the environments falls back to the first environment.
+func (p *Pretty) SetEnvironments(environments []string) {
+	var choices []PrettyChoice
+	for _, c := range p.Choices {
+		if c.Type != "environment" {
+			choices = append(choices, c)
+			continue
+		}
+
+		if len(environments) == 0 {
+			p.Inputs = append(p.Inputs, PrettyInput{
+				ID:          c.ID,
+				Key:         c.Key,
+				Value:       c.Value,
+				Default:     c.Default,
+				Description: c.Description,
+				Type:        c.Type,
+				Required:    c.Required,
+			})
+			continue
+		}
+
+		c.Values = environments
+		if !slices.Contains(environments, c.Default) {
+			c.Default = environments[0]
+		}
+		if c.Value != "" && !slices.Contains(environments, c.Value) {
+			c.Value = c.Default
+		}
+		choices = append(choices, c)
+	}
+	p.Choices = choices
+}
+
+// Validate checks the values of the inputs against their declared types
+func (p *Pretty) Validate() error {
+	for _, kv := range p.KeyVals {
+		if kv.node == nil || kv.Value == "" {
+			continue
+		}
+		if err := kv.node.CheckValue(kv.Value); err != nil {
+			return fmt.Errorf("input %s.%s: %w", *kv.Parent, kv.Key, err)
+		}
+	}
+
+	for _, i := range p.Inputs {
+		if i.Type != "number" || i.Value == "" {
+			continue
+		}
+		if !IsNumber(i.Value) {
+			return fmt.Errorf("input %s must be a number, got %q", i.Key, i.Value)
+		}
+	}
+	return nil
+}
+
+// numberPattern is a decimal number, ParseFloat also accepts hex, underscores, NaN and Inf which GitHub rejects
+var numberPattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+
+// IsNumber reports whether the value is a valid number input, a finite decimal number
+func IsNumber(value string) bool {
+	value = strings.TrimSpace(value)
+	if !numberPattern.MatchString(value) {
+		return false
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	return err == nil && !math.IsInf(number, 0) && !math.IsNaN(number)
+}
+
 func (p *Pretty) ToJson() (string, error) {
 	// Create a map to hold the aggregated data
 	result := make(map[string]any)
 
-	// Process KeyVals
+	// Process KeyVals, JSON inputs are written into copies of their trees to keep the order and types of values
+	roots, err := p.jsonInputs(func(kv PrettyKeyValue) string { return kv.Value }, true)
+	if err != nil {
+		return "", err
+	}
 	for _, kv := range p.KeyVals {
-		if kv.Parent != nil {
+		if kv.Parent != nil && kv.node != nil {
+			continue
+		} else if kv.Parent != nil {
 			parent := *kv.Parent
 			if _, ok := result[parent]; !ok {
 				result[parent] = make(map[string]any)
@@ -154,6 +302,14 @@ func (p *Pretty) ToJson() (string, error) {
 		}
 	}
 
+	for parent, root := range roots {
+		content, err := json.Marshal(root)
+		if err != nil {
+			return "", err
+		}
+		result[parent] = string(content)
+	}
+
 	// Process Choices
 	for _, c := range p.Choices {
 		result[c.Key] = c.Value
@@ -181,6 +337,78 @@ func (p *Pretty) ToJson() (string, error) {
 	return string(modifiedJSON), nil
 }
 
+// InputValues returns the values of the inputs like the inputs context of a run, empty values fall back to their
+// defaults. Boolean inputs are booleans and number inputs are numbers, the others are strings.
+func (p *Pretty) InputValues() map[string]any {
+	var values = make(map[string]any)
+
+	// Values which don't match the types of their keys are left out of the preview
+	roots, _ := p.jsonInputs(func(kv PrettyKeyValue) string { return valueOrDefault(kv.Value, kv.Default) }, false)
+	for parent, root := range roots {
+		if content, err := json.Marshal(root); err == nil {
+			values[parent] = string(content)
+		}
+	}
+
+	for _, c := range p.Choices {
+		values[c.Key] = valueOrDefault(c.Value, c.Default)
+	}
+
+	for _, i := range p.Inputs {
+		value := valueOrDefault(i.Value, i.Default)
+		values[i.Key] = value
+		if i.Type == "number" {
+			if number, err := strconv.ParseFloat(value, 64); err == nil {
+				values[i.Key] = number
+			}
+		}
+	}
+
+	for _, b := range p.Boolean {
+		values[b.Key] = valueOrDefault(b.Value, b.Default) == "true"
+	}
+
+	return values
+}
+
+// jsonInputs writes the values of the JSON inputs into copies of their trees, the trees of the inputs are kept as
+// they are. If strict is set, a value which doesn't match the type of its key is an error, otherwise it is skipped.
+func (p *Pretty) jsonInputs(valueOf func(PrettyKeyValue) string, strict bool) (map[string]*py.JSONNode, error) {
+	var roots = make(map[string]*py.JSONNode)
+	var leaves = make(map[string][]py.JSONLeaf)
+	var copies = make(map[string][]py.JSONLeaf)
+	for _, kv := range p.KeyVals {
+		if kv.Parent == nil || kv.root == nil || kv.node == nil {
+			continue
+		}
+
+		// The leaves of a copy are in the same order as the leaves of its tree
+		parent := *kv.Parent
+		if _, ok := roots[parent]; !ok {
+			roots[parent] = kv.root.Clone()
+			leaves[parent] = kv.root.Leaves()
+			copies[parent] = roots[parent].Leaves()
+		}
+
+		index := slices.IndexFunc(leaves[parent], func(leaf py.JSONLeaf) bool { return leaf.Node == kv.node })
+		value := valueOf(kv)
+		if index < 0 || value == "" {
+			continue
+		}
+		if err := copies[parent][index].Node.SetValue(value); err != nil && strict {
+			return nil, fmt.Errorf("input %s.%s: %w", parent, kv.Key, err)
+		}
+	}
+	return roots, nil
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
 func convertJsonToString(m map[string]any) error {
 	for k, v := range m {
 		if reflect.TypeOf(v).Kind() == reflect.Map {
@@ -208,6 +436,10 @@ type PrettyChoice struct {
 	Value   string
 	Values  []string
 	Default string
+
+	Description string
+	Type        string
+	Required    bool
 }
 
 func (c *PrettyChoice) SetValue(value string) {
@@ -220,6 +452,10 @@ type PrettyInput struct {
 	Value   string
 	Values  []string
 	Default string
+
+	Description string
+	Type        string
+	Required    bool
 }
 
 func (i *PrettyInput) SetValue(value string) {
@@ -232,16 +468,185 @@ type PrettyKeyValue struct {
 	Key     string
 	Value   string
 	Default string
+
+	// Description, Type and Required belong to the parent input
+	Description string
+	Type        string
+	Required    bool
+
+	// JSONType is the type of the value in JSON, like string, number, boolean or null
+	JSONType string
+
+	// root is the JSON tree of the parent input, node is the value of this key in the tree
+	root *py.JSONNode
+	node *py.JSONNode
 }
 
 func (kv *PrettyKeyValue) SetValue(value string) {
 	kv.Value = value
 }
 
+// CheckValue returns an error if the value doesn't match the JSON type of the key
+func (kv *PrettyKeyValue) CheckValue(value string) error {
+	if kv.node == nil {
+		return nil
+	}
+	return kv.node.CheckValue(value)
+}
+
+// JSONContainer returns the type of the object or array a key or an item is added to from the JSON key with the
+// ID: the key itself if it is an empty object or array, otherwise the object or array which holds it
+func (p *Pretty) JSONContainer(id int) (py.JSONKind, bool) {
+	kv, ok := p.jsonKeyValue(id)
+	if !ok {
+		return "", false
+	}
+	container := kv.container()
+	if container == nil {
+		return "", false
+	}
+	return container.Kind, true
+}
+
+// AddJSONChild adds a key to the object or an item to the array of the JSON key with the ID, see JSONContainer.
+// The key is ignored for arrays.
+func (p *Pretty) AddJSONChild(id int, key string) error {
+	kv, ok := p.jsonKeyValue(id)
+	if !ok {
+		return fmt.Errorf("no JSON key with ID %d", id)
+	}
+	container := kv.container()
+	if container == nil {
+		return fmt.Errorf("%s is not in an object or an array", kv.Key)
+	}
+	if _, err := container.AddChild(key); err != nil {
+		return err
+	}
+
+	p.rebuildJSONInput(*kv.Parent)
+	return nil
+}
+
+// RemoveJSONNode removes the JSON key with the ID from its object or array
+func (p *Pretty) RemoveJSONNode(id int) error {
+	kv, ok := p.jsonKeyValue(id)
+	if !ok {
+		return fmt.Errorf("no JSON key with ID %d", id)
+	}
+	parent := kv.root.ParentOf(kv.node)
+	if parent == nil {
+		return errors.New("the value of the input cannot be removed")
+	}
+	parent.RemoveChild(kv.node)
+
+	p.rebuildJSONInput(*kv.Parent)
+	return nil
+}
+
+func (p *Pretty) jsonKeyValue(id int) (PrettyKeyValue, bool) {
+	for _, kv := range p.KeyVals {
+		if kv.ID == id && kv.Parent != nil && kv.root != nil && kv.node != nil {
+			return kv, true
+		}
+	}
+	return PrettyKeyValue{}, false
+}
+
+// rebuildJSONInput lists the leaves of the tree of the JSON input again after keys or items are added or removed.
+// The keys which are still in the tree keep their values, and the IDs of all the inputs are renumbered to keep
+// the declaration order.
+func (p *Pretty) rebuildJSONInput(parent string) {
+	first := slices.IndexFunc(p.KeyVals, func(kv PrettyKeyValue) bool { return kv.Parent != nil && *kv.Parent == parent })
+	if first < 0 {
+		return
+	}
+	last := first
+	for last+1 < len(p.KeyVals) && p.KeyVals[last+1].Parent != nil && *p.KeyVals[last+1].Parent == parent {
+		last++
+	}
+	template := p.KeyVals[first]
+	previous := make(map[*py.JSONNode]PrettyKeyValue)
+	for _, kv := range p.KeyVals[first : last+1] {
+		previous[kv.node] = kv
+	}
+
+	var rebuilt []PrettyKeyValue
+	for _, leaf := range template.root.Leaves() {
+		kv, ok := previous[leaf.Node]
+		if !ok {
+			kv = template
+			kv.Value = ""
+			kv.Default = leaf.Node.Value
+			kv.node = leaf.Node
+		}
+		kv.Key = leaf.Path
+		kv.JSONType = string(leaf.Node.Kind)
+		rebuilt = append(rebuilt, kv)
+	}
+	p.KeyVals = slices.Concat(p.KeyVals[:first], rebuilt, p.KeyVals[last+1:])
+
+	// The keys of the input take the place of its first key in the declaration order
+	type inputID struct {
+		id    *int
+		order int
+		index int
+	}
+	var ids []inputID
+	for i := range p.KeyVals {
+		if p.KeyVals[i].Parent != nil && *p.KeyVals[i].Parent == parent {
+			ids = append(ids, inputID{&p.KeyVals[i].ID, template.ID, i})
+			continue
+		}
+		ids = append(ids, inputID{&p.KeyVals[i].ID, p.KeyVals[i].ID, 0})
+	}
+	for i := range p.Choices {
+		ids = append(ids, inputID{&p.Choices[i].ID, p.Choices[i].ID, 0})
+	}
+	for i := range p.Inputs {
+		ids = append(ids, inputID{&p.Inputs[i].ID, p.Inputs[i].ID, 0})
+	}
+	for i := range p.Boolean {
+		ids = append(ids, inputID{&p.Boolean[i].ID, p.Boolean[i].ID, 0})
+	}
+	slices.SortStableFunc(ids, func(a, b inputID) int {
+		if a.order != b.order {
+			return a.order - b.order
+		}
+		return a.index - b.index
+	})
+	for i, id := range ids {
+		*id.id = i
+	}
+}
+
+// container returns the object or array a key or an item is added to, see Pretty.JSONContainer
+func (kv *PrettyKeyValue) container() *py.JSONNode {
+	if kv.node.IsContainer() {
+		return kv.node
+	}
+	return kv.root.ParentOf(kv.node)
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
 
+// inputKeys returns the input names in declaration order, falls back to sorted order if the order is unknown
+func inputKeys(dispatch py.WorkflowDispatch) []string {
+	if len(dispatch.InputKeys) == len(dispatch.Inputs) {
+		return dispatch.InputKeys
+	}
+	return slices.Sorted(maps.Keys(dispatch.Inputs))
+}
+
+// inputType returns the declared type of the input, GitHub treats inputs without type as string
+func inputType(value py.WorkflowInput) string {
+	if value.Type == "" {
+		return "string"
+	}
+	return value.Type
+}
+
 func parseChoiceTypes(value py.WorkflowInput) Content {
 	var defaultValue = ""
 	if value.Default == nil {
@@ -259,6 +664,7 @@ func parseChoiceTypes(value py.WorkflowInput) Content {
 		Description: value.Description,
 		Type:        "choice",
 		Required:    value.Required,
+		InputType:   inputType(value),
 		Choice: &Choice{
 			Default: defaultValue,
 			Options: value.Options,
@@ -284,6 +690,7 @@ func parseBooleanTypes(value py.WorkflowInput) Content {
 		Description: value.Description,
 		Type:        "bool",
 		Required:    value.Required,
+		InputType:   inputType(value),
 		Boolean: &Value{
 			Default: defaultValue,
 			Options: options,
@@ -306,9 +713,58 @@ func parseInputTypes(value py.WorkflowInput) Content {
 		Description: value.Description,
 		Type:        "input",
 		Required:    value.Required,
+		InputType:   inputType(value),
 		Value: &Value{
 			Default: defaultValue,
 			Value:   "",
 		},
 	}
 }
+
+func parseNumberTypes(value py.WorkflowInput) Content {
+	var defaultValue = ""
+
+	// YAML decodes numeric defaults to int or float64, keep them as they are written
+	switch res := value.Default.(type) {
+	case int:
+		defaultValue = strconv.Itoa(res)
+	case float64:
+		defaultValue = strconv.FormatFloat(res, 'f', -1, 64)
+	case string:
+		defaultValue = res
+	}
+
+	return Content{
+		Description: value.Description,
+		Type:        "input",
+		Required:    value.Required,
+		InputType:   inputType(value),
+		Value: &Value{
+			Default: defaultValue,
+			Value:   "",
+		},
+	}
+}
+
+func parseEnvironmentTypes(value py.WorkflowInput) Content {
+	var defaultValue = ""
+
+	if value.Default != nil {
+		res, ok := value.Default.(string)
+		if ok {
+			defaultValue = res
+		}
+	}
+
+	// Options are filled with the repository's environments later on
+	return Content{
+		Description: value.Description,
+		Type:        "choice",
+		Required:    value.Required,
+		InputType:   inputType(value),
+		Choice: &Choice{
+			Default: defaultValue,
+			Value:   "",
+		},
+	}
+}
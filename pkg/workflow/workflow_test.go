@@ -71,3 +71,367 @@ on:
 
 	t.Log(w)
 }
+
+func TestWorkflow_ToPretty_InputDetails(t *testing.T) {
+	var data = []byte(`
+on:
+  workflow_dispatch:
+    inputs:
+      environment:
+        description: 'Target environment'
+        type: choice
+        required: true
+        options:
+          - 'staging'
+          - 'production'
+      version:
+        description: 'Version to deploy'
+      dry_run:
+        description: 'Dry run'
+        type: boolean
+      components:
+        description: 'Component refs'
+        required: true
+        default: '{"api": "main"}'
+`)
+
+	var workflow py.WorkflowContent
+	err := yaml.Unmarshal(data, &workflow)
+	assert.NoError(t, err)
+
+	w, err := ParseWorkflow(workflow)
+	assert.NoError(t, err)
+
+	pretty := w.ToPretty()
+
+	assert.Len(t, pretty.Choices, 1)
+	assert.Equal(t, "Target environment", pretty.Choices[0].Description)
+	assert.Equal(t, "choice", pretty.Choices[0].Type)
+	assert.True(t, pretty.Choices[0].Required)
+
+	assert.Len(t, pretty.Inputs, 1)
+	assert.Equal(t, "Version to deploy", pretty.Inputs[0].Description)
+	assert.Equal(t, "string", pretty.Inputs[0].Type)
+	assert.False(t, pretty.Inputs[0].Required)
+
+	assert.Len(t, pretty.Boolean, 1)
+	assert.Equal(t, "Dry run", pretty.Boolean[0].Description)
+	assert.Equal(t, "boolean", pretty.Boolean[0].Type)
+
+	assert.Len(t, pretty.KeyVals, 1)
+	assert.Equal(t, "Component refs", pretty.KeyVals[0].Description)
+	assert.True(t, pretty.KeyVals[0].Required)
+}
+
+func TestParseWorkflow_NumberAndEnvironment(t *testing.T) {
+	var data = []byte(`
+on:
+  workflow_dispatch:
+    inputs:
+      replicas:
+        type: number
+        default: 3
+      ratio:
+        type: number
+        default: 0.5
+      target:
+        type: environment
+        required: true
+`)
+
+	var workflow py.WorkflowContent
+	err := yaml.Unmarshal(data, &workflow)
+	assert.NoError(t, err)
+
+	w, err := ParseWorkflow(workflow)
+	assert.NoError(t, err)
+
+	pretty := w.ToPretty()
+
+	defaults := make(map[string]string)
+	for _, input := range pretty.Inputs {
+		assert.Equal(t, "number", input.Type)
+		defaults[input.Key] = input.Default
+	}
+	assert.Equal(t, map[string]string{"replicas": "3", "ratio": "0.5"}, defaults)
+
+	assert.True(t, pretty.HasEnvironments())
+	assert.Len(t, pretty.Choices, 1)
+	assert.Equal(t, "environment", pretty.Choices[0].Type)
+
+	t.Run("with environments", func(t *testing.T) {
+		p := w.ToPretty()
+		p.SetEnvironments([]string{"staging", "production"})
+
+		assert.Len(t, p.Choices, 1)
+		assert.Equal(t, []string{"staging", "production"}, p.Choices[0].Values)
+		assert.Equal(t, "staging", p.Choices[0].Default)
+	})
+
+	t.Run("with a default which is not an environment", func(t *testing.T) {
+		p := w.ToPretty()
+		p.Choices[0].Default = "qa"
+		p.SetEnvironments([]string{"staging", "production"})
+
+		assert.Equal(t, "staging", p.Choices[0].Default)
+	})
+
+	t.Run("without environments", func(t *testing.T) {
+		p := w.ToPretty()
+		p.SetEnvironments(nil)
+
+		assert.Empty(t, p.Choices)
+		assert.Len(t, p.Inputs, 3)
+	})
+}
+
+func TestPretty_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{name: "empty", value: "", wantErr: false},
+		{name: "integer", value: "42", wantErr: false},
+		{name: "float", value: "-1.25", wantErr: false},
+		{name: "text", value: "ten", wantErr: true},
+		{name: "exponent", value: "1e3", wantErr: false},
+		{name: "leading dot", value: ".5", wantErr: false},
+		{name: "not a number", value: "NaN", wantErr: true},
+		{name: "infinity", value: "Inf", wantErr: true},
+		{name: "overflow", value: "1e400", wantErr: true},
+		{name: "hex", value: "0x1p-2", wantErr: true},
+		{name: "underscores", value: "1_000", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Pretty{Inputs: []PrettyInput{{Key: "count", Type: "number", Value: tt.value}}}
+			err := p.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWorkflow_ToPretty_DeclarationOrder(t *testing.T) {
+	var data = []byte(`
+on:
+  workflow_dispatch:
+    inputs:
+      zeta:
+        type: string
+      components:
+        default: '{"ui": "main", "api": "stable", "db": "v2"}'
+      alpha:
+        type: choice
+        options: [a, b]
+      mid:
+        type: boolean
+`)
+
+	var workflow py.WorkflowContent
+	err := yaml.Unmarshal(data, &workflow)
+	assert.NoError(t, err)
+
+	for range 10 {
+		w, err := ParseWorkflow(workflow)
+		assert.NoError(t, err)
+
+		pretty := w.ToPretty()
+
+		keys := make(map[int]string)
+		for _, kv := range pretty.KeyVals {
+			keys[kv.ID] = *kv.Parent + "." + kv.Key
+		}
+		for _, c := range pretty.Choices {
+			keys[c.ID] = c.Key
+		}
+		for _, i := range pretty.Inputs {
+			keys[i.ID] = i.Key
+		}
+		for _, b := range pretty.Boolean {
+			keys[b.ID] = b.Key
+		}
+
+		assert.Equal(t, map[int]string{
+			0: "zeta",
+			1: "components.ui",
+			2: "components.api",
+			3: "components.db",
+			4: "alpha",
+			5: "mid",
+		}, keys)
+	}
+}
+
+func TestWorkflow_ToPretty_UnknownTypeKeepsOrder(t *testing.T) {
+	var data = []byte(`
+on:
+  workflow_dispatch:
+    inputs:
+      zeta:
+        type: string
+      typo:
+        type: strnig
+        default: main
+      alpha:
+        type: choice
+        options: [a, b]
+`)
+
+	var workflow py.WorkflowContent
+	err := yaml.Unmarshal(data, &workflow)
+	assert.NoError(t, err)
+
+	w, err := ParseWorkflow(workflow)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"zeta", "typo", "alpha"}, w.Keys)
+
+	pretty := w.ToPretty()
+	assert.Len(t, pretty.Inputs, 2)
+	assert.Equal(t, 0, pretty.Inputs[0].ID)
+	assert.Equal(t, "zeta", pretty.Inputs[0].Key)
+	assert.Equal(t, 1, pretty.Inputs[1].ID)
+	assert.Equal(t, "typo", pretty.Inputs[1].Key)
+	assert.Equal(t, "main", pretty.Inputs[1].Default)
+	assert.Len(t, pretty.Choices, 1)
+	assert.Equal(t, 2, pretty.Choices[0].ID)
+}
+
+func TestPretty_ToJson_TypedJSONInput(t *testing.T) {
+	var data = []byte(`
+on:
+  workflow_dispatch:
+    inputs:
+      config:
+        default: '{"replicas": 2, "canary": false, "regions": ["eu", "us"], "db": {"name": "main"}}'
+`)
+
+	var workflow py.WorkflowContent
+	err := yaml.Unmarshal(data, &workflow)
+	assert.NoError(t, err)
+
+	w, err := ParseWorkflow(workflow)
+	assert.NoError(t, err)
+
+	pretty := w.ToPretty()
+
+	var keys []string
+	for _, kv := range pretty.KeyVals {
+		keys = append(keys, kv.Key)
+	}
+	assert.Equal(t, []string{"replicas", "canary", "regions[0]", "regions[1]", "db.name"}, keys)
+	assert.Equal(t, "number", pretty.KeyVals[0].JSONType)
+
+	pretty.KeyVals[0].SetValue("5")
+	pretty.KeyVals[1].SetValue("true")
+	pretty.KeyVals[3].SetValue("ap")
+
+	content, err := pretty.ToJson()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"config": "{\"replicas\":5,\"canary\":true,\"regions\":[\"eu\",\"ap\"],\"db\":{\"name\":\"main\"}}"}`, content)
+
+	// Values of other Pretty instances are not shared
+	other, err := w.ToPretty().ToJson()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"config": "{\"replicas\":2,\"canary\":false,\"regions\":[\"eu\",\"us\"],\"db\":{\"name\":\"main\"}}"}`, other)
+
+	// The tree of the Pretty is not changed, a cleared value goes back to the default
+	pretty.KeyVals[0].SetValue("")
+	content, err = pretty.ToJson()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"config": "{\"replicas\":2,\"canary\":true,\"regions\":[\"eu\",\"ap\"],\"db\":{\"name\":\"main\"}}"}`, content)
+
+	pretty.KeyVals[0].SetValue("five")
+	assert.Error(t, pretty.Validate())
+}
+
+func TestPretty_EditJSONTree(t *testing.T) {
+	var data = []byte(`
+on:
+  workflow_dispatch:
+    inputs:
+      config:
+        default: '{"regions": ["eu"], "db": {"name": "main"}}'
+      dry_run:
+        type: boolean
+`)
+
+	var workflow py.WorkflowContent
+	err := yaml.Unmarshal(data, &workflow)
+	assert.NoError(t, err)
+
+	w, err := ParseWorkflow(workflow)
+	assert.NoError(t, err)
+	pretty := w.ToPretty()
+	pretty.KeyVals[0].SetValue("us")
+
+	kind, ok := pretty.JSONContainer(pretty.KeyVals[1].ID)
+	assert.True(t, ok)
+	assert.Equal(t, py.JSONObject, kind)
+
+	// A key of the db object and an item of the regions array
+	assert.NoError(t, pretty.AddJSONChild(pretty.KeyVals[1].ID, "port"))
+	assert.Error(t, pretty.AddJSONChild(pretty.KeyVals[1].ID, "port"))
+	assert.NoError(t, pretty.AddJSONChild(pretty.KeyVals[0].ID, ""))
+
+	var keys []string
+	for _, kv := range pretty.KeyVals {
+		keys = append(keys, kv.Key)
+	}
+	assert.Equal(t, []string{"regions[0]", "regions[1]", "db.name", "db.port"}, keys)
+	assert.Equal(t, "us", pretty.KeyVals[0].Value, "values of existing keys are kept")
+	assert.Equal(t, []int{0, 1, 2, 3}, []int{pretty.KeyVals[0].ID, pretty.KeyVals[1].ID, pretty.KeyVals[2].ID, pretty.KeyVals[3].ID})
+	assert.Equal(t, 4, pretty.Boolean[0].ID, "inputs after the JSON input are renumbered")
+
+	pretty.KeyVals[3].SetValue("5432")
+	content, err := pretty.ToJson()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"config": "{\"regions\":[\"us\",\"eu\"],\"db\":{\"name\":\"main\",\"port\":5432}}", "dry_run": ""}`, content)
+
+	assert.NoError(t, pretty.RemoveJSONNode(pretty.KeyVals[0].ID))
+	assert.NoError(t, pretty.RemoveJSONNode(pretty.KeyVals[1].ID))
+	content, err = pretty.ToJson()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"config": "{\"regions\":[\"eu\"],\"db\":{\"port\":5432}}", "dry_run": ""}`, content)
+}
+
+func TestPretty_InputValues(t *testing.T) {
+	var data = []byte(`
+on:
+  workflow_dispatch:
+    inputs:
+      environment:
+        type: choice
+        options: [staging, production]
+        default: staging
+      replicas:
+        type: number
+        default: 2
+      dry_run:
+        type: boolean
+        default: false
+      regions:
+        default: '["eu-west-1"]'
+`)
+
+	content, err := py.UnmarshalWorkflowContent(data)
+	assert.NoError(t, err)
+
+	workflow, err := ParseWorkflow(*content)
+	assert.NoError(t, err)
+
+	pretty := workflow.ToPretty()
+	pretty.Choices[0].SetValue("production")
+	pretty.Boolean[0].SetValue("true")
+
+	assert.Equal(t, map[string]any{
+		"environment": "production",
+		"replicas":    float64(2),
+		"dry_run":     true,
+		"regions":     `["eu-west-1"]`,
+	}, pretty.InputValues())
+}
@@ -0,0 +1,334 @@
+package yaml
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type JSONKind string
+
+const (
+	JSONObject JSONKind = "object"
+	JSONArray  JSONKind = "array"
+	JSONString JSONKind = "string"
+	JSONNumber JSONKind = "number"
+	JSONBool   JSONKind = "boolean"
+	JSONNull   JSONKind = "null"
+)
+
+// JSONNode is a JSON value which keeps the order of object keys and the type of scalar values
+type JSONNode struct {
+	Kind JSONKind
+
+	// Key is the key of the node in its parent object, it is empty for array items and the root
+	Key string
+
+	// Value is the text of scalar values, strings are kept unquoted
+	Value string
+
+	// Children holds the items of objects and arrays
+	Children []*JSONNode
+}
+
+// JSONLeaf is a scalar node with its path from the root, like "db.hosts[0]"
+type JSONLeaf struct {
+	Path string
+	Node *JSONNode
+}
+
+// ParseJSON parses the data as JSON while keeping the order of object keys
+func ParseJSON(data string) (*JSONNode, error) {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+
+	node, err := decodeJSONNode(decoder)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure there is nothing left after the value
+	if _, err := decoder.Token(); err == nil {
+		return nil, errors.New("invalid JSON: unexpected data after top-level value")
+	}
+
+	return node, nil
+}
+
+func decodeJSONNode(decoder *json.Decoder) (*JSONNode, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := token.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			node := &JSONNode{Kind: JSONObject}
+			for decoder.More() {
+				keyToken, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+				key, ok := keyToken.(string)
+				if !ok {
+					return nil, fmt.Errorf("invalid JSON object key: %v", keyToken)
+				}
+
+				child, err := decodeJSONNode(decoder)
+				if err != nil {
+					return nil, err
+				}
+				child.Key = key
+				node.Children = append(node.Children, child)
+			}
+			if _, err := decoder.Token(); err != nil { // closing }
+				return nil, err
+			}
+			return node, nil
+		case '[':
+			node := &JSONNode{Kind: JSONArray}
+			for decoder.More() {
+				child, err := decodeJSONNode(decoder)
+				if err != nil {
+					return nil, err
+				}
+				node.Children = append(node.Children, child)
+			}
+			if _, err := decoder.Token(); err != nil { // closing ]
+				return nil, err
+			}
+			return node, nil
+		}
+		return nil, fmt.Errorf("invalid JSON delimiter: %v", t)
+	case string:
+		return &JSONNode{Kind: JSONString, Value: t}, nil
+	case json.Number:
+		return &JSONNode{Kind: JSONNumber, Value: t.String()}, nil
+	case bool:
+		return &JSONNode{Kind: JSONBool, Value: strconv.FormatBool(t)}, nil
+	case nil:
+		return &JSONNode{Kind: JSONNull, Value: "null"}, nil
+	}
+
+	return nil, fmt.Errorf("invalid JSON token: %v", token)
+}
+
+// IsContainer reports whether the node is an object or an array
+func (n *JSONNode) IsContainer() bool {
+	return n.Kind == JSONObject || n.Kind == JSONArray
+}
+
+// Leaves returns the scalar nodes and the empty objects and arrays of the tree in declaration order, the empty
+// ones are kept so keys and items can be added to them
+func (n *JSONNode) Leaves() []JSONLeaf {
+	var leaves []JSONLeaf
+	n.collectLeaves("", &leaves)
+	return leaves
+}
+
+func (n *JSONNode) collectLeaves(path string, leaves *[]JSONLeaf) {
+	if !n.IsContainer() || len(n.Children) == 0 {
+		*leaves = append(*leaves, JSONLeaf{Path: path, Node: n})
+		return
+	}
+
+	for i, child := range n.Children {
+		var childPath string
+		if n.Kind == JSONArray {
+			childPath = fmt.Sprintf("%s[%d]", path, i)
+		} else if path == "" {
+			childPath = child.Key
+		} else {
+			childPath = path + "." + child.Key
+		}
+		child.collectLeaves(childPath, leaves)
+	}
+}
+
+// Clone returns a deep copy of the node
+func (n *JSONNode) Clone() *JSONNode {
+	clone := &JSONNode{
+		Kind:  n.Kind,
+		Key:   n.Key,
+		Value: n.Value,
+	}
+	for _, child := range n.Children {
+		clone.Children = append(clone.Children, child.Clone())
+	}
+	return clone
+}
+
+// ParentOf returns the object or array which holds the node in the tree, it is nil for the root and for nodes which
+// are not in the tree
+func (n *JSONNode) ParentOf(node *JSONNode) *JSONNode {
+	for _, child := range n.Children {
+		if child == node {
+			return n
+		}
+		if parent := child.ParentOf(node); parent != nil {
+			return parent
+		}
+	}
+	return nil
+}
+
+// AddChild adds a key to an object or an item to an array. A new key is null until a value is set. A new item is
+// a copy of the last item, so the items of an array keep their shape, or null if the array is empty.
+func (n *JSONNode) AddChild(key string) (*JSONNode, error) {
+	switch n.Kind {
+	case JSONObject:
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, errors.New("the key cannot be empty")
+		}
+		for _, child := range n.Children {
+			if child.Key == key {
+				return nil, fmt.Errorf("the key %s already exists", key)
+			}
+		}
+		child := &JSONNode{Kind: JSONNull, Key: key, Value: "null"}
+		n.Children = append(n.Children, child)
+		return child, nil
+	case JSONArray:
+		child := &JSONNode{Kind: JSONNull, Value: "null"}
+		if len(n.Children) > 0 {
+			child = n.Children[len(n.Children)-1].Clone()
+		}
+		n.Children = append(n.Children, child)
+		return child, nil
+	}
+	return nil, errors.New("keys and items can only be added to objects and arrays")
+}
+
+// RemoveChild removes the key of an object or the item of an array, it reports whether the child was in the node
+func (n *JSONNode) RemoveChild(child *JSONNode) bool {
+	for i, c := range n.Children {
+		if c == child {
+			n.Children = append(n.Children[:i], n.Children[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// CheckValue returns an error if the value cannot be stored in the scalar node without changing its type
+func (n *JSONNode) CheckValue(value string) error {
+	switch n.Kind {
+	case JSONNumber:
+		if !isJSONNumber(value) {
+			return fmt.Errorf("%q is not a number", value)
+		}
+	case JSONBool:
+		if value != "true" && value != "false" {
+			return fmt.Errorf("%q is not a boolean", value)
+		}
+	case JSONObject, JSONArray:
+		return errors.New("objects and arrays cannot be set directly")
+	}
+	return nil
+}
+
+// SetValue sets the value of a scalar node, keeping its type.
+// A null node takes any value, its type is inferred from the value: numbers, booleans and null keep their types,
+// a quoted value is a string without its quotes and anything else is a string.
+func (n *JSONNode) SetValue(value string) error {
+	if err := n.CheckValue(value); err != nil {
+		return err
+	}
+
+	if n.Kind == JSONNull {
+		n.Kind, value = inferJSONKind(value)
+	}
+	n.Value = strings.TrimSpace(value)
+	if n.Kind == JSONString {
+		n.Value = value
+	}
+
+	return nil
+}
+
+func (n *JSONNode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := n.writeJSON(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (n *JSONNode) writeJSON(buf *bytes.Buffer) error {
+	switch n.Kind {
+	case JSONObject, JSONArray:
+		open, closing := byte('{'), byte('}')
+		if n.Kind == JSONArray {
+			open, closing = '[', ']'
+		}
+
+		buf.WriteByte(open)
+		for i, child := range n.Children {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if n.Kind == JSONObject {
+				key, err := json.Marshal(child.Key)
+				if err != nil {
+					return err
+				}
+				buf.Write(key)
+				buf.WriteByte(':')
+			}
+			if err := child.writeJSON(buf); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(closing)
+	case JSONString:
+		value, err := json.Marshal(n.Value)
+		if err != nil {
+			return err
+		}
+		buf.Write(value)
+	case JSONNumber, JSONBool:
+		if err := n.CheckValue(n.Value); err != nil {
+			return err
+		}
+		buf.WriteString(n.Value)
+	case JSONNull:
+		buf.WriteString("null")
+	default:
+		return fmt.Errorf("unknown JSON kind: %s", n.Kind)
+	}
+
+	return nil
+}
+
+// inferJSONKind returns the type of a value typed for a null node and the value without its quotes
+func inferJSONKind(value string) (JSONKind, string) {
+	trimmed := strings.TrimSpace(value)
+	switch {
+	case trimmed == "null":
+		return JSONNull, trimmed
+	case trimmed == "true" || trimmed == "false":
+		return JSONBool, trimmed
+	case isJSONNumber(trimmed):
+		return JSONNumber, trimmed
+	case strings.HasPrefix(trimmed, `"`):
+		var unquoted string
+		if err := json.Unmarshal([]byte(trimmed), &unquoted); err == nil {
+			return JSONString, unquoted
+		}
+	}
+	return JSONString, value
+}
+
+func isJSONNumber(value string) bool {
+	value = strings.TrimSpace(value)
+	if value == "" || (value[0] != '-' && (value[0] < '0' || value[0] > '9')) {
+		return false
+	}
+	return json.Valid([]byte(value))
+}
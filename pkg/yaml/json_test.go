@@ -0,0 +1,174 @@
+package yaml
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJSON(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		wantErr    bool
+		wantLeaves map[string]JSONKind
+		wantPaths  []string
+	}{
+		{
+			name:      "flat object",
+			data:      `{"b": "x", "a": "y"}`,
+			wantPaths: []string{"b", "a"},
+			wantLeaves: map[string]JSONKind{
+				"b": JSONString,
+				"a": JSONString,
+			},
+		},
+		{
+			name:      "nested and typed",
+			data:      `{"db": {"port": 5432, "tls": true, "hosts": ["a", "b"]}, "extra": null}`,
+			wantPaths: []string{"db.port", "db.tls", "db.hosts[0]", "db.hosts[1]", "extra"},
+			wantLeaves: map[string]JSONKind{
+				"db.port":     JSONNumber,
+				"db.tls":      JSONBool,
+				"db.hosts[0]": JSONString,
+				"db.hosts[1]": JSONString,
+				"extra":       JSONNull,
+			},
+		},
+		{
+			name:      "array root",
+			data:      `[1, {"a": 2.5}]`,
+			wantPaths: []string{"[0]", "[1].a"},
+			wantLeaves: map[string]JSONKind{
+				"[0]":   JSONNumber,
+				"[1].a": JSONNumber,
+			},
+		},
+		{
+			name:    "invalid",
+			data:    `{"a": }`,
+			wantErr: true,
+		},
+		{
+			name:    "trailing data",
+			data:    `{"a": 1} {"b": 2}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := ParseJSON(tt.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			var paths []string
+			for _, leaf := range node.Leaves() {
+				paths = append(paths, leaf.Path)
+				assert.Equal(t, tt.wantLeaves[leaf.Path], leaf.Node.Kind, leaf.Path)
+			}
+			assert.Equal(t, tt.wantPaths, paths)
+		})
+	}
+}
+
+func TestJSONNode_MarshalJSON(t *testing.T) {
+	var data = `{"z":1,"a":{"enabled":false,"ratio":0.25,"tags":["x","y"]},"n":null,"s":"text"}`
+
+	node, err := ParseJSON(data)
+	assert.NoError(t, err)
+
+	out, err := json.Marshal(node)
+	assert.NoError(t, err)
+	assert.Equal(t, data, string(out))
+}
+
+func TestJSONNode_SetValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		value    string
+		wantErr  bool
+		wantJSON string
+	}{
+		{name: "number", data: `{"a":1}`, value: "42", wantJSON: `{"a":42}`},
+		{name: "invalid number", data: `{"a":1}`, value: "forty", wantErr: true},
+		{name: "boolean", data: `{"a":true}`, value: "false", wantJSON: `{"a":false}`},
+		{name: "invalid boolean", data: `{"a":true}`, value: "yes", wantErr: true},
+		{name: "string keeps numbers quoted", data: `{"a":"1"}`, value: "2", wantJSON: `{"a":"2"}`},
+		{name: "null stays null", data: `{"a":null}`, value: "null", wantJSON: `{"a":null}`},
+		{name: "null becomes string", data: `{"a":null}`, value: "x", wantJSON: `{"a":"x"}`},
+		{name: "null becomes number", data: `{"a":null}`, value: "5", wantJSON: `{"a":5}`},
+		{name: "null becomes boolean", data: `{"a":null}`, value: "true", wantJSON: `{"a":true}`},
+		{name: "null becomes quoted string", data: `{"a":null}`, value: `"5"`, wantJSON: `{"a":"5"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := ParseJSON(tt.data)
+			assert.NoError(t, err)
+
+			err = node.Leaves()[0].Node.SetValue(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			out, err := json.Marshal(node)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantJSON, string(out))
+		})
+	}
+}
+
+func TestJSONNode_AddChild(t *testing.T) {
+	node, err := ParseJSON(`{"db":{"port":5432},"hosts":[{"name":"a"}],"tags":[]}`)
+	assert.NoError(t, err)
+
+	db := node.Children[0]
+	_, err = db.AddChild("user")
+	assert.NoError(t, err)
+	_, err = db.AddChild("port")
+	assert.Error(t, err, "keys are unique")
+	_, err = db.AddChild(" ")
+	assert.Error(t, err, "keys cannot be empty")
+
+	_, err = node.Children[1].AddChild("")
+	assert.NoError(t, err)
+	_, err = node.Children[2].AddChild("")
+	assert.NoError(t, err)
+	_, err = db.Children[0].AddChild("x")
+	assert.Error(t, err, "scalars have no children")
+
+	out, err := json.Marshal(node)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"db":{"port":5432,"user":null},"hosts":[{"name":"a"},{"name":"a"}],"tags":[null]}`, string(out))
+}
+
+func TestJSONNode_RemoveChild(t *testing.T) {
+	node, err := ParseJSON(`{"db":{"port":5432},"hosts":["a","b"]}`)
+	assert.NoError(t, err)
+
+	port := node.Children[0].Children[0]
+	assert.Equal(t, node.Children[0], node.ParentOf(port))
+	assert.Nil(t, node.ParentOf(node))
+
+	assert.True(t, node.ParentOf(port).RemoveChild(port))
+	hosts := node.Children[1]
+	assert.True(t, hosts.RemoveChild(hosts.Children[0]))
+	assert.False(t, hosts.RemoveChild(port))
+
+	out, err := json.Marshal(node)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"db":{},"hosts":["b"]}`, string(out))
+
+	// The empty object is still a leaf, so keys can be added to it
+	var paths []string
+	for _, leaf := range node.Leaves() {
+		paths = append(paths, leaf.Path)
+	}
+	assert.Equal(t, []string{"db", "hosts[0]"}, paths)
+}
@@ -0,0 +1,212 @@
+package yaml
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/termkit/gama/pkg/cron"
+	"gopkg.in/yaml.v3"
+)
+
+// Finding is a problem found in a workflow file
+type Finding struct {
+	Line    int
+	Column  int
+	Rule    string // short name of the check, like needs or cron
+	Message string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%d:%d: %s (%s)", f.Line, f.Column, f.Message, f.Rule)
+}
+
+var (
+	// dispatchInputTypes are the types of workflow_dispatch inputs, an input without a type is a string
+	dispatchInputTypes = []string{"string", "boolean", "choice", "number", "environment"}
+
+	// callInputTypes are the types of the inputs of reusable workflows
+	callInputTypes = []string{"string", "boolean", "number"}
+
+	syntaxErrorLinePattern = regexp.MustCompile(`^yaml: line (\d+): `)
+)
+
+// Lint checks the structure of a workflow file: the types, defaults and options of the inputs, the needs and IDs of
+// the jobs and the cron expressions of the schedules. A file which is not valid YAML has a single syntax finding.
+func Lint(data []byte) []Finding {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		finding := Finding{Line: 1, Column: 1, Rule: "syntax", Message: err.Error()}
+		if match := syntaxErrorLinePattern.FindStringSubmatch(err.Error()); match != nil {
+			finding.Line, _ = strconv.Atoi(match[1])
+			finding.Message = strings.TrimPrefix(err.Error(), match[0])
+		}
+		return []Finding{finding}
+	}
+	if len(document.Content) == 0 {
+		return []Finding{{Line: 1, Column: 1, Rule: "syntax", Message: "workflow file is empty"}}
+	}
+
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return []Finding{{Line: root.Line, Column: root.Column, Rule: "syntax",
+			Message: fmt.Sprintf("workflow must be a mapping, got %s", nodeKind(root))}}
+	}
+
+	var findings []Finding
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		switch {
+		case isOnKey(key):
+			findings = append(findings, lintTriggers(value)...)
+		case key.Value == "jobs":
+			findings = append(findings, lintJobs(value)...)
+		}
+	}
+
+	slices.SortStableFunc(findings, func(a, b Finding) int {
+		if a.Line != b.Line {
+			return a.Line - b.Line
+		}
+		return a.Column - b.Column
+	})
+	return findings
+}
+
+func lintTriggers(node *yaml.Node) []Finding {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	var findings []Finding
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		switch key.Value {
+		case "workflow_dispatch":
+			findings = append(findings, lintInputs(mappingValue(value, "inputs"), dispatchInputTypes)...)
+		case "workflow_call":
+			findings = append(findings, lintInputs(mappingValue(value, "inputs"), callInputTypes)...)
+		case "schedule":
+			if value.Kind != yaml.SequenceNode {
+				continue
+			}
+			for _, schedule := range value.Content {
+				expression := mappingValue(schedule, "cron")
+				if expression == nil {
+					continue
+				}
+				if _, err := cron.Describe(expression.Value); err != nil {
+					findings = append(findings, Finding{Line: expression.Line, Column: expression.Column, Rule: "cron",
+						Message: fmt.Sprintf("invalid cron %q: %v", expression.Value, err)})
+				}
+			}
+		}
+	}
+	return findings
+}
+
+func lintInputs(node *yaml.Node, types []string) []Finding {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	var findings []Finding
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		name, input := node.Content[i].Value, node.Content[i+1]
+		if input.Kind != yaml.MappingNode {
+			continue
+		}
+
+		inputType := "string"
+		if typeNode := mappingValue(input, "type"); typeNode != nil {
+			inputType = typeNode.Value
+			if !slices.Contains(types, inputType) {
+				findings = append(findings, Finding{Line: typeNode.Line, Column: typeNode.Column, Rule: "input-type",
+					Message: fmt.Sprintf("input %s has unknown type %q, expected one of %s",
+						name, inputType, strings.Join(types, ", "))})
+				continue
+			}
+		}
+
+		defaultNode := mappingValue(input, "default")
+		hasDefault := defaultNode != nil && defaultNode.Tag != "!!null"
+
+		if required := mappingValue(input, "required"); required != nil && required.Value == "true" && hasDefault {
+			findings = append(findings, Finding{Line: required.Line, Column: required.Column, Rule: "required-default",
+				Message: fmt.Sprintf("input %s is required but has a default, it can never be left empty", name)})
+		}
+
+		if inputType != "choice" {
+			continue
+		}
+		var options []string
+		if optionsNode := mappingValue(input, "options"); optionsNode != nil {
+			options, _ = decodeStrings(optionsNode)
+		}
+		switch {
+		case len(options) == 0:
+			findings = append(findings, Finding{Line: input.Line, Column: input.Column, Rule: "choice-options",
+				Message: fmt.Sprintf("choice input %s has no options", name)})
+		case hasDefault && !slices.Contains(options, defaultNode.Value):
+			findings = append(findings, Finding{Line: defaultNode.Line, Column: defaultNode.Column, Rule: "choice-default",
+				Message: fmt.Sprintf("default %q of input %s is not one of its options", defaultNode.Value, name)})
+		}
+	}
+	return findings
+}
+
+func lintJobs(node *yaml.Node) []Finding {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	var findings []Finding
+	var lines = make(map[string]int)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i]
+		if line, ok := lines[key.Value]; ok {
+			findings = append(findings, Finding{Line: key.Line, Column: key.Column, Rule: "duplicate-job",
+				Message: fmt.Sprintf("job %s is already defined at line %d", key.Value, line)})
+			continue
+		}
+		lines[key.Value] = key.Line
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		id, job := node.Content[i].Value, node.Content[i+1]
+
+		needs := mappingValue(job, "needs")
+		if needs == nil {
+			continue
+		}
+		items := []*yaml.Node{needs}
+		if needs.Kind == yaml.SequenceNode {
+			items = needs.Content
+		}
+		for _, need := range items {
+			if need.Kind != yaml.ScalarNode || need.Tag == "!!null" {
+				continue
+			}
+			if _, ok := lines[need.Value]; !ok {
+				findings = append(findings, Finding{Line: need.Line, Column: need.Column, Rule: "needs",
+					Message: fmt.Sprintf("job %s needs unknown job %s", id, need.Value)})
+			}
+		}
+	}
+	return findings
+}
+
+// mappingValue returns the value of the key in a mapping node, or nil if the node is not a mapping or has no such key
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
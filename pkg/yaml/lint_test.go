@@ -0,0 +1,69 @@
+package yaml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLint(t *testing.T) {
+	data := []byte(`name: Deploy
+on:
+  workflow_dispatch:
+    inputs:
+      target:
+        type: choice
+        options: [staging, production]
+        default: qa
+      version:
+        type: text
+      force:
+        type: boolean
+        required: true
+        default: false
+      region:
+        type: choice
+  workflow_call:
+    inputs:
+      target:
+        type: choice
+  schedule:
+    - cron: '0 5 * * 1'
+    - cron: '61 5 * * *'
+jobs:
+  build:
+    runs-on: ubuntu-latest
+  deploy:
+    needs: [build, test]
+    runs-on: ubuntu-latest
+  build:
+    needs: setup
+    runs-on: ubuntu-latest
+`)
+
+	var got []string
+	for _, finding := range Lint(data) {
+		got = append(got, finding.String())
+	}
+
+	assert.Equal(t, []string{
+		`8:18: default "qa" of input target is not one of its options (choice-default)`,
+		`10:15: input version has unknown type "text", expected one of string, boolean, choice, number, environment (input-type)`,
+		`13:19: input force is required but has a default, it can never be left empty (required-default)`,
+		`16:9: choice input region has no options (choice-options)`,
+		`20:15: input target has unknown type "choice", expected one of string, boolean, number (input-type)`,
+		`23:13: invalid cron "61 5 * * *": value 61 out of range 0-59 in minute field (cron)`,
+		`28:20: job deploy needs unknown job test (needs)`,
+		`30:3: job build is already defined at line 25 (duplicate-job)`,
+		`31:12: job build needs unknown job setup (needs)`,
+	}, got)
+}
+
+func TestLint_Syntax(t *testing.T) {
+	findings := Lint([]byte("on: push\njobs:\n  build:\n  runs-on: [ubuntu\n"))
+	assert.Len(t, findings, 1)
+	assert.Equal(t, "syntax", findings[0].Rule)
+	assert.Equal(t, 3, findings[0].Line)
+
+	assert.Equal(t, []Finding{{Line: 1, Column: 1, Rule: "syntax", Message: "workflow file is empty"}}, Lint(nil))
+}
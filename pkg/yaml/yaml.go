@@ -1,27 +1,322 @@
 package yaml
 
 import (
-	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
 
 type WorkflowContent struct {
-	Name string `yaml:"name"`
-	On   struct {
-		WorkflowDispatch struct {
-			Inputs map[string]WorkflowInput `yaml:"inputs"`
-		} `yaml:"workflow_dispatch"`
-	} `yaml:"on"`
+	Name string           `yaml:"name"`
+	On   WorkflowTriggers `yaml:"on"`
+
+	// Jobs is the list of jobs in declaration order
+	Jobs []WorkflowJob `yaml:"jobs"`
+}
+
+func (w *WorkflowContent) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("workflow must be a mapping, got %s", nodeKind(node))
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+
+		switch {
+		case key.Value == "name":
+			if err := value.Decode(&w.Name); err != nil {
+				return err
+			}
+		case isOnKey(key):
+			if err := value.Decode(&w.On); err != nil {
+				return err
+			}
+		case key.Value == "jobs":
+			w.Jobs = decodeJobs(value)
+		}
+	}
+
+	return nil
+}
+
+// isOnKey reports whether the key is the "on" key of a workflow.
+// YAML 1.1 parsers read a bare on as boolean true, so workflows re-written by such tools have a true key instead.
+func isOnKey(key *yaml.Node) bool {
+	if key.Value == "on" {
+		return true
+	}
+	return key.Tag == "!!bool" && strings.EqualFold(key.Value, "true")
+}
+
+// WorkflowTriggers is the "on" key of a workflow, it can be a single event, a list of events or a map of events
+type WorkflowTriggers struct {
+	// Events is the list of events that trigger the workflow, in declaration order
+	Events []string
+
+	// Schedules is the list of cron expressions of the schedule event
+	Schedules []string
+
+	// RepositoryDispatchTypes is the list of event types of the repository_dispatch event,
+	// it is empty when the workflow runs for every event type
+	RepositoryDispatchTypes []string
+
+	WorkflowDispatch WorkflowDispatch `yaml:"workflow_dispatch"`
+
+	// WorkflowCall holds the inputs of a reusable workflow, they are declared like the ones of workflow_dispatch
+	WorkflowCall WorkflowDispatch `yaml:"workflow_call"`
+}
+
+type WorkflowDispatch struct {
+	Inputs map[string]WorkflowInput `yaml:"inputs"`
+
+	// InputKeys is the list of input names in declaration order, since Inputs is a map it doesn't keep the order
+	InputKeys []string `yaml:"-"`
+}
+
+func (d *WorkflowDispatch) UnmarshalYAML(node *yaml.Node) error {
+	// Define a shadow type to avoid recursion
+	type shadow WorkflowDispatch
+	if err := node.Decode((*shadow)(d)); err != nil {
+		return err
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if key.Value != "inputs" || value.Kind != yaml.MappingNode {
+			continue
+		}
+
+		for j := 0; j+1 < len(value.Content); j += 2 {
+			d.InputKeys = append(d.InputKeys, value.Content[j].Value)
+		}
+	}
+
+	return nil
+}
+
+func (t *WorkflowTriggers) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		// on: workflow_dispatch
+		if node.Tag == "!!null" {
+			return nil
+		}
+		t.Events = []string{node.Value}
+	case yaml.SequenceNode:
+		// on: [push, workflow_dispatch]
+		var events []string
+		if err := node.Decode(&events); err != nil {
+			return err
+		}
+		t.Events = events
+	case yaml.MappingNode:
+		// on:
+		//   workflow_dispatch:
+		//     inputs: ...
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			t.Events = append(t.Events, key.Value)
+
+			switch {
+			case key.Value == "workflow_dispatch" && value.Kind == yaml.MappingNode:
+				if err := value.Decode(&t.WorkflowDispatch); err != nil {
+					return err
+				}
+			case key.Value == "workflow_call" && value.Kind == yaml.MappingNode:
+				if err := value.Decode(&t.WorkflowCall); err != nil {
+					return err
+				}
+			case key.Value == "schedule" && value.Kind == yaml.SequenceNode:
+				// schedule:
+				//   - cron: '30 5 * * 1'
+				var schedules []struct {
+					Cron string `yaml:"cron"`
+				}
+				if err := value.Decode(&schedules); err != nil {
+					return err
+				}
+				for _, schedule := range schedules {
+					if schedule.Cron != "" {
+						t.Schedules = append(t.Schedules, schedule.Cron)
+					}
+				}
+			case key.Value == "repository_dispatch" && value.Kind == yaml.MappingNode:
+				// repository_dispatch:
+				//   types: [deploy, release]
+				types, err := decodeTypes(value)
+				if err != nil {
+					return err
+				}
+				t.RepositoryDispatchTypes = types
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported on key, got %s", nodeKind(node))
+	}
+
+	return nil
+}
+
+// decodeTypes decodes the "types" key of an event, it can be a single type or a list of types
+func decodeTypes(node *yaml.Node) ([]string, error) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if key.Value != "types" {
+			continue
+		}
+
+		return decodeStrings(value)
+	}
+	return nil, nil
+}
+
+// Has reports whether the workflow is triggered by the given event
+func (t WorkflowTriggers) Has(event string) bool {
+	return slices.Contains(t.Events, event)
+}
+
+func nodeKind(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		return "document"
+	case yaml.SequenceNode:
+		return "sequence"
+	case yaml.MappingNode:
+		return "mapping"
+	case yaml.ScalarNode:
+		return "scalar"
+	case yaml.AliasNode:
+		return "alias"
+	default:
+		return "unknown"
+	}
+}
+
+// WorkflowJob is the outline of a job, the steps are not parsed
+type WorkflowJob struct {
+	ID     string
+	Name   string
+	Needs  []string // IDs of the jobs which must complete before this job
+	RunsOn []string // runner labels, a runner group is listed as "group: <name>"
+	Uses   string   // reusable workflow called by the job
+	If     string   // condition of the job as it is written
+
+	// MatrixAxes is the list of matrix variables in declaration order, include and exclude are not axes
+	MatrixAxes []string
+
+	// Matrix is the strategy.matrix of the job as it is written, it is a string if the matrix is an expression
+	Matrix any
+}
+
+type workflowJob struct {
+	Name     yaml.Node `yaml:"name"`
+	Needs    yaml.Node `yaml:"needs"`
+	RunsOn   yaml.Node `yaml:"runs-on"`
+	Uses     yaml.Node `yaml:"uses"`
+	If       yaml.Node `yaml:"if"`
+	Strategy yaml.Node `yaml:"strategy"`
+}
+
+// decodeJobs decodes the outline of the jobs leniently, the parts of a job which are written in an unknown shape,
+// like a strategy written as an expression, are left empty instead of failing the whole workflow
+func decodeJobs(node *yaml.Node) []WorkflowJob {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	var jobs []WorkflowJob
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+
+		job := WorkflowJob{ID: key.Value}
+
+		var content workflowJob
+		if value.Kind != yaml.MappingNode || value.Decode(&content) != nil {
+			jobs = append(jobs, job)
+			continue
+		}
+
+		job.Name = scalarValue(&content.Name)
+		job.Uses = scalarValue(&content.Uses)
+		job.If = scalarValue(&content.If)
+		job.Needs, _ = decodeStrings(&content.Needs)
+
+		if content.RunsOn.Kind == yaml.MappingNode {
+			// runs-on:
+			//   group: ubuntu-runners
+			//   labels: [ubuntu-20.04-16core]
+			var runsOn struct {
+				Group  yaml.Node `yaml:"group"`
+				Labels yaml.Node `yaml:"labels"`
+			}
+			if content.RunsOn.Decode(&runsOn) == nil {
+				if group := scalarValue(&runsOn.Group); group != "" {
+					job.RunsOn = append(job.RunsOn, "group: "+group)
+				}
+				labels, _ := decodeStrings(&runsOn.Labels)
+				job.RunsOn = append(job.RunsOn, labels...)
+			}
+		} else {
+			job.RunsOn, _ = decodeStrings(&content.RunsOn)
+		}
+
+		if matrix := mappingValue(&content.Strategy, "matrix"); matrix != nil {
+			if matrix.Kind == yaml.MappingNode {
+				for j := 0; j+1 < len(matrix.Content); j += 2 {
+					if axis := matrix.Content[j].Value; axis != "include" && axis != "exclude" {
+						job.MatrixAxes = append(job.MatrixAxes, axis)
+					}
+				}
+			}
+			if matrix.Decode(&job.Matrix) != nil {
+				job.MatrixAxes, job.Matrix = nil, nil
+			}
+		}
+
+		jobs = append(jobs, job)
+	}
+
+	return jobs
+}
+
+// scalarValue returns the value of a scalar node, it returns an empty value for other nodes
+func scalarValue(node *yaml.Node) string {
+	if node.Kind != yaml.ScalarNode || node.Tag == "!!null" {
+		return ""
+	}
+	return node.Value
+}
+
+// decodeStrings decodes a single value or a list of values, it returns nil for a missing node
+func decodeStrings(node *yaml.Node) ([]string, error) {
+	switch node.Kind {
+	case 0:
+		return nil, nil
+	case yaml.ScalarNode:
+		if node.Tag == "!!null" {
+			return nil, nil
+		}
+		return []string{node.Value}, nil
+	case yaml.SequenceNode:
+		var values []string
+		if err := node.Decode(&values); err != nil {
+			return nil, err
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("expected a value or a list, got %s", nodeKind(node))
+	}
 }
 
 type WorkflowInput struct {
-	Description string            `yaml:"description"`
-	Required    bool              `yaml:"required"`
-	Default     any               `yaml:"default,omitempty"`
-	Type        string            `yaml:"type,omitempty"`
-	Options     []string          `yaml:"options,omitempty"`
-	JSONContent map[string]string `yaml:"-"` // This field is for internal use and won't be filled directly by the YAML unmarshaler
+	Description string    `yaml:"description"`
+	Required    bool      `yaml:"required"`
+	Default     any       `yaml:"default,omitempty"`
+	Type        string    `yaml:"type,omitempty"`
+	Options     []string  `yaml:"options,omitempty"`
+	JSONContent *JSONNode `yaml:"-"` // This field is for internal use and won't be filled directly by the YAML unmarshaler
 }
 
 func (i *WorkflowInput) UnmarshalYAML(unmarshal func(any) error) error {
@@ -34,10 +329,10 @@ func (i *WorkflowInput) UnmarshalYAML(unmarshal func(any) error) error {
 	// Process the default value based on its actual type
 	switch def := i.Default.(type) {
 	case string:
-		// Attempt to unmarshal JSON content if the default value is a string
-		tempMap := make(map[string]string)
-		if err := json.Unmarshal([]byte(def), &tempMap); err == nil {
-			i.JSONContent = tempMap
+		// Attempt to unmarshal JSON content if the default value is a string,
+		// only objects and arrays with at least one value are editable as JSON
+		if node, err := ParseJSON(def); err == nil && node.IsContainer() && len(node.Leaves()) > 0 {
+			i.JSONContent = node
 		}
 	case bool:
 		// Handle boolean values
@@ -64,3 +64,227 @@ on:
 	assert.Equal(t, "trial", workflow.On.WorkflowDispatch.Inputs["deployment_zone"].Default)
 	assert.Equal(t, "choice", workflow.On.WorkflowDispatch.Inputs["deployment_zone"].Type)
 }
+
+func TestWorkflowTriggers_UnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		name             string
+		data             string
+		wantEvents       []string
+		wantDispatchable bool
+		wantInputs       []string
+	}{
+		{
+			name:             "scalar",
+			data:             "on: workflow_dispatch\n",
+			wantEvents:       []string{"workflow_dispatch"},
+			wantDispatchable: true,
+		},
+		{
+			name:             "scalar without dispatch",
+			data:             "on: push\n",
+			wantEvents:       []string{"push"},
+			wantDispatchable: false,
+		},
+		{
+			name:             "flow sequence",
+			data:             "on: [push, workflow_dispatch]\n",
+			wantEvents:       []string{"push", "workflow_dispatch"},
+			wantDispatchable: true,
+		},
+		{
+			name:             "block sequence",
+			data:             "on:\n  - pull_request\n  - workflow_dispatch\n",
+			wantEvents:       []string{"pull_request", "workflow_dispatch"},
+			wantDispatchable: true,
+		},
+		{
+			name:             "mapping with empty dispatch",
+			data:             "on:\n  push:\n    branches: [main]\n  workflow_dispatch:\n",
+			wantEvents:       []string{"push", "workflow_dispatch"},
+			wantDispatchable: true,
+		},
+		{
+			name:             "mapping with inputs",
+			data:             "on:\n  workflow_dispatch:\n    inputs:\n      version:\n        type: string\n",
+			wantEvents:       []string{"workflow_dispatch"},
+			wantDispatchable: true,
+			wantInputs:       []string{"version"},
+		},
+		{
+			name:             "yaml 1.1 boolean key",
+			data:             "true:\n  workflow_dispatch:\n",
+			wantEvents:       []string{"workflow_dispatch"},
+			wantDispatchable: true,
+		},
+		{
+			name:             "missing on",
+			data:             "name: CI\n",
+			wantEvents:       nil,
+			wantDispatchable: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workflow, err := UnmarshalWorkflowContent([]byte(tt.data))
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantEvents, workflow.On.Events)
+			assert.Equal(t, tt.wantDispatchable, workflow.On.Has("workflow_dispatch"))
+
+			var inputs []string
+			for input := range workflow.On.WorkflowDispatch.Inputs {
+				inputs = append(inputs, input)
+			}
+			assert.Equal(t, tt.wantInputs, inputs)
+		})
+	}
+}
+
+func TestWorkflowDispatch_InputKeys(t *testing.T) {
+	var data = []byte(`
+on:
+  workflow_dispatch:
+    inputs:
+      zeta:
+        type: string
+      components:
+        default: '{"ui": "main", "api": "stable"}'
+      alpha:
+        type: boolean
+`)
+
+	workflow, err := UnmarshalWorkflowContent(data)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"zeta", "components", "alpha"}, workflow.On.WorkflowDispatch.InputKeys)
+
+	var jsonKeys []string
+	for _, leaf := range workflow.On.WorkflowDispatch.Inputs["components"].JSONContent.Leaves() {
+		jsonKeys = append(jsonKeys, leaf.Path)
+	}
+	assert.Equal(t, []string{"ui", "api"}, jsonKeys)
+}
+
+func TestWorkflowTriggers_Schedules(t *testing.T) {
+	var data = []byte(`
+on:
+  push:
+  schedule:
+    - cron: '30 5 * * 1,3'
+    - cron: '0 0 1 * *'
+`)
+
+	workflow, err := UnmarshalWorkflowContent(data)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"push", "schedule"}, workflow.On.Events)
+	assert.Equal(t, []string{"30 5 * * 1,3", "0 0 1 * *"}, workflow.On.Schedules)
+}
+
+func TestWorkflowTriggers_RepositoryDispatchTypes(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []string
+	}{
+		{
+			name: "list of types",
+			data: "on:\n  repository_dispatch:\n    types: [deploy, rollback]\n",
+			want: []string{"deploy", "rollback"},
+		},
+		{
+			name: "single type",
+			data: "on:\n  repository_dispatch:\n    types: deploy\n",
+			want: []string{"deploy"},
+		},
+		{
+			name: "every type",
+			data: "on:\n  repository_dispatch:\n",
+			want: nil,
+		},
+		{
+			name: "scalar event",
+			data: "on: repository_dispatch\n",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workflow, err := UnmarshalWorkflowContent([]byte(tt.data))
+			assert.NoError(t, err)
+
+			assert.True(t, workflow.On.Has("repository_dispatch"))
+			assert.Equal(t, tt.want, workflow.On.RepositoryDispatchTypes)
+		})
+	}
+}
+
+func TestWorkflowContent_Jobs(t *testing.T) {
+	var data = []byte(`
+on: push
+jobs:
+  build:
+    name: Build
+    runs-on: ubuntu-latest
+    strategy:
+      matrix:
+        os: [ubuntu-latest, macos-latest]
+        go: ['1.23', '1.24']
+        include:
+          - os: windows-latest
+            go: '1.24'
+  test:
+    needs: build
+    runs-on: [self-hosted, linux]
+  deploy:
+    needs: [build, test]
+    if: github.ref == 'refs/heads/main'
+    runs-on:
+      group: production
+      labels: deployer
+  release:
+    needs: deploy
+    uses: ./.github/workflows/release.yaml
+`)
+
+	workflow, err := UnmarshalWorkflowContent(data)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []WorkflowJob{
+		{ID: "build", Name: "Build", RunsOn: []string{"ubuntu-latest"}, MatrixAxes: []string{"os", "go"},
+			Matrix: map[string]any{
+				"os":      []any{"ubuntu-latest", "macos-latest"},
+				"go":      []any{"1.23", "1.24"},
+				"include": []any{map[string]any{"os": "windows-latest", "go": "1.24"}},
+			}},
+		{ID: "test", Needs: []string{"build"}, RunsOn: []string{"self-hosted", "linux"}},
+		{ID: "deploy", Needs: []string{"build", "test"}, If: "github.ref == 'refs/heads/main'", RunsOn: []string{"group: production", "deployer"}},
+		{ID: "release", Needs: []string{"deploy"}, Uses: "./.github/workflows/release.yaml"},
+	}, workflow.Jobs)
+}
+
+func TestWorkflowContent_JobsUnknownShapes(t *testing.T) {
+	// Parts of jobs in unknown shapes are left empty, the workflow and the other jobs are still read
+	var data = []byte(`
+on: workflow_dispatch
+jobs:
+  build:
+    runs-on: ${{ inputs.runner }}
+    strategy: ${{ fromJSON(inputs.strategy) }}
+  test:
+    needs: { job: build }
+    runs-on:
+      group: [linux]
+      labels: tester
+  lint: lint everything
+`)
+
+	workflow, err := UnmarshalWorkflowContent(data)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []WorkflowJob{
+		{ID: "build", RunsOn: []string{"${{ inputs.runner }}"}},
+		{ID: "test", RunsOn: []string{"tester"}},
+		{ID: "lint"},
+	}, workflow.Jobs)
+}